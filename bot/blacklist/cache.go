@@ -11,6 +11,7 @@ import (
 var (
 	blacklistedGuilds = make(map[uint64]struct{})
 	blacklistedUsers  = make(map[uint64]struct{})
+	lastRefreshed     time.Time
 	mu                sync.RWMutex
 )
 
@@ -50,19 +51,35 @@ func RefreshCache(ctx context.Context) error {
 
 	blacklistedGuilds = guildMap
 	blacklistedUsers = userMap
+	lastRefreshed = time.Now()
 
 	return nil
 }
 
-func StartCacheRefreshLoop(logger *zap.Logger) {
+// LastRefreshed returns the time that the cache was last refreshed successfully, or the zero time if it never has been
+func LastRefreshed() time.Time {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	return lastRefreshed
+}
+
+// StartCacheRefreshLoop refreshes the cache periodically until ctx is cancelled
+func StartCacheRefreshLoop(ctx context.Context, logger *zap.Logger) {
 	logger.Info("Starting blacklist cache refresh loop")
 
 	timer := time.NewTicker(time.Minute * 5)
+	defer timer.Stop()
 
 	for {
-		<-timer.C
+		select {
+		case <-ctx.Done():
+			logger.Info("Stopping blacklist cache refresh loop")
+			return
+		case <-timer.C:
+		}
 
-		if err := RefreshCache(context.Background()); err != nil {
+		if err := RefreshCache(ctx); err != nil {
 			logger.Error("Failed to refresh blacklist cache", zap.Error(err))
 			continue
 		}
@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"github.com/TicketsBot/worker/bot/button/registry"
+	"github.com/TicketsBot/worker/bot/button/registry/matcher"
+	"github.com/TicketsBot/worker/bot/command/context"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/i18n"
+	"time"
+)
+
+type CloseAllCancelHandler struct{}
+
+func (h *CloseAllCancelHandler) Matcher() matcher.Matcher {
+	return &matcher.SimpleMatcher{
+		CustomId: "closeall_cancel",
+	}
+}
+
+func (h *CloseAllCancelHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:   registry.SumFlags(registry.GuildAllowed, registry.CanEdit),
+		Timeout: time.Second * 3,
+	}
+}
+
+func (h *CloseAllCancelHandler) Execute(ctx *context.ButtonContext) {
+	// The pending request expires by itself, so there is nothing to clean up
+	ctx.EditWith(customisation.Green, i18n.TitleCloseAll, i18n.MessageCloseAllCancelled)
+}
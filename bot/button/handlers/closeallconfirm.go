@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"context"
+	permcache "github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/common/sentry"
+	"github.com/TicketsBot/database"
+	"github.com/TicketsBot/worker/bot/button/registry"
+	"github.com/TicketsBot/worker/bot/button/registry/matcher"
+	cmdcontext "github.com/TicketsBot/worker/bot/command/context"
+	"github.com/TicketsBot/worker/bot/constants"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/lifecycle"
+	"github.com/TicketsBot/worker/bot/logic"
+	"github.com/TicketsBot/worker/bot/redis"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/channel/embed"
+	"github.com/rxdn/gdl/rest"
+	"strings"
+	"time"
+)
+
+type CloseAllConfirmHandler struct {
+	Lifecycle *lifecycle.Manager
+}
+
+const (
+	closeAllConfirmPrefix = "closeall_confirm_"
+
+	// Pace closes so that a large batch doesn't exhaust the channel deletion and message rate limits
+	closeAllInterval = time.Second
+
+	// Edit the progress message at most this often
+	closeAllProgressInterval = time.Second * 5
+)
+
+func (h *CloseAllConfirmHandler) Matcher() matcher.Matcher {
+	return matcher.NewFuncMatcher(func(customId string) bool {
+		return strings.HasPrefix(customId, closeAllConfirmPrefix)
+	})
+}
+
+func (h *CloseAllConfirmHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:   registry.SumFlags(registry.GuildAllowed, registry.CanEdit),
+		Timeout: time.Second * 10,
+	}
+}
+
+func (h *CloseAllConfirmHandler) Execute(ctx *cmdcontext.ButtonContext) {
+	permLevel, err := ctx.UserPermissionLevel(ctx)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if permLevel < permcache.Admin {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNoPermission)
+		return
+	}
+
+	requestId := strings.TrimPrefix(ctx.InteractionData.CustomId, closeAllConfirmPrefix)
+
+	var request logic.CloseAllRequest
+	ok, err := redis.TakeCloseAllRequest(ctx, requestId, &request)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !ok || request.UserId != ctx.UserId() {
+		ctx.EditWith(customisation.Red, i18n.Error, i18n.MessageCloseAllExpired)
+		return
+	}
+
+	// Re-run the filter, as tickets may have been closed since the preview was shown
+	tickets, err := logic.FindTicketsToClose(ctx, ctx.GuildId(), request.Filter)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if len(tickets) == 0 {
+		ctx.EditWith(customisation.Red, i18n.Error, i18n.MessageCloseAllNone)
+		return
+	}
+
+	// If the command was run inside a ticket that is being closed, close it last so the progress message survives
+	for i, ticket := range tickets {
+		if ticket.ChannelId != nil && *ticket.ChannelId == ctx.ChannelId() {
+			tickets = append(append(tickets[:i:i], tickets[i+1:]...), ticket)
+			break
+		}
+	}
+
+	progressEmbed := utils.BuildEmbed(ctx, customisation.Orange, i18n.TitleCloseAll, i18n.MessageCloseAllProgress, nil, 0, len(tickets))
+	progressMessage, err := ctx.Worker().CreateMessageEmbed(ctx.ChannelId(), progressEmbed)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.EditWith(customisation.Green, i18n.TitleCloseAll, i18n.MessageCloseAllStarted, len(tickets))
+
+	// The interaction token expires long before a large batch finishes, so run detached and report through the
+	// progress message instead
+	progressCtx := cmdcontext.NewAutoCloseContext(
+		context.Background(), ctx.Worker(), ctx.GuildId(), ctx.ChannelId(), ctx.UserId(), ctx.PremiumTier(),
+	)
+
+	h.Lifecycle.Go(func() {
+		closeAll(h.Lifecycle.Context(), progressCtx, request, tickets, progressMessage.Id)
+	})
+}
+
+// closeAll closes the tickets one at a time, until they have all been closed or stopCtx is cancelled by the worker
+// shutting down, in which case the partial results are reported
+func closeAll(stopCtx context.Context, ctx *cmdcontext.AutoCloseContext, request logic.CloseAllRequest, tickets []database.Ticket, progressMessageId uint64) {
+	// Execute moves the ticket the command was run in to the end. Closing it deletes the progress message, so the
+	// results have to be reported before it is closed.
+	last := tickets[len(tickets)-1]
+	closesSelf := last.ChannelId != nil && *last.ChannelId == ctx.ChannelId()
+
+	var closed, failed int
+	lastProgress := time.Now()
+
+	for i, ticket := range tickets {
+		if stopCtx.Err() != nil {
+			interruptedEmbed := utils.BuildEmbed(ctx, customisation.Red, i18n.TitleCloseAll, i18n.MessageCloseAllInterrupted, nil, closed, failed, len(tickets)-i)
+			editCloseAllProgress(ctx, progressMessageId, interruptedEmbed)
+			return
+		}
+
+		if closesSelf && i == len(tickets)-1 {
+			completeEmbed := utils.BuildEmbed(ctx, customisation.Green, i18n.TitleCloseAll, i18n.MessageCloseAllCompleteSelf, nil, closed, failed)
+			editCloseAllProgress(ctx, progressMessageId, completeEmbed)
+		}
+
+		if closeAllTicket(ctx, request, ticket) {
+			closed++
+		} else {
+			failed++
+		}
+
+		if i == len(tickets)-1 {
+			break
+		}
+
+		if time.Since(lastProgress) >= closeAllProgressInterval {
+			progressEmbed := utils.BuildEmbed(ctx, customisation.Orange, i18n.TitleCloseAll, i18n.MessageCloseAllProgress, nil, i+1, len(tickets))
+			editCloseAllProgress(ctx, progressMessageId, progressEmbed)
+			lastProgress = time.Now()
+		}
+
+		select {
+		case <-stopCtx.Done():
+		case <-time.After(closeAllInterval):
+		}
+	}
+
+	// If the ticket the command was run in was closed, this edit fails along with the channel, and the results have
+	// already been reported
+	completeEmbed := utils.BuildEmbed(ctx, customisation.Green, i18n.TitleCloseAll, i18n.MessageCloseAllComplete, nil, closed, failed)
+	editCloseAllProgress(ctx, progressMessageId, completeEmbed)
+}
+
+// closeAllTicket closes a single ticket, returning whether it is now closed
+func closeAllTicket(ctx *cmdcontext.AutoCloseContext, request logic.CloseAllRequest, ticket database.Ticket) bool {
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), constants.TimeoutCloseTicket)
+	defer cancel()
+
+	closeCtx := cmdcontext.NewAutoCloseContext(timeoutCtx, ctx.Worker(), ticket.GuildId, *ticket.ChannelId, request.UserId, ctx.PremiumTier())
+	logic.CloseTicket(timeoutCtx, closeCtx, request.Reason, true)
+
+	// CloseTicket handles its own errors, so check the outcome directly
+	updated, err := dbclient.Client.Tickets.Get(timeoutCtx, ticket.Id, ticket.GuildId)
+	if err != nil {
+		sentry.ErrorWithContext(err, closeCtx.ToErrorContext())
+		return false
+	}
+
+	return !updated.Open
+}
+
+func editCloseAllProgress(ctx *cmdcontext.AutoCloseContext, messageId uint64, e *embed.Embed) {
+	data := rest.EditMessageData{
+		Embeds: utils.Slice(e),
+	}
+
+	// Ignore errors, as the channel may have been closed as part of the batch
+	_, _ = ctx.Worker().EditMessage(ctx.ChannelId(), messageId, data)
+}
@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	permcache "github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/button/registry"
+	"github.com/TicketsBot/worker/bot/button/registry/matcher"
+	"github.com/TicketsBot/worker/bot/command/context"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/logic"
+	"github.com/TicketsBot/worker/i18n"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type RemindCancelHandler struct{}
+
+func (h *RemindCancelHandler) Matcher() matcher.Matcher {
+	return matcher.NewFuncMatcher(func(customId string) bool {
+		return strings.HasPrefix(customId, "remind_cancel_")
+	})
+}
+
+func (h *RemindCancelHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:   registry.SumFlags(registry.GuildAllowed, registry.CanEdit),
+		Timeout: time.Second * 3,
+	}
+}
+
+func (h *RemindCancelHandler) Execute(ctx *context.ButtonContext) {
+	permLevel, err := ctx.UserPermissionLevel(ctx)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if permLevel < permcache.Support {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNoPermission)
+		return
+	}
+
+	id, err := strconv.Atoi(strings.TrimPrefix(ctx.InteractionData.CustomId, "remind_cancel_"))
+	if err != nil {
+		return
+	}
+
+	reminder, ok, err := dbclient.WorkerDb.TicketReminders.Get(ctx, id)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !ok || reminder.GuildId != ctx.GuildId() {
+		ctx.EditWith(customisation.Red, i18n.Error, i18n.MessageRemindNotFound)
+		return
+	}
+
+	cancelled, err := logic.CancelReminder(ctx, reminder.Id)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !cancelled {
+		ctx.EditWith(customisation.Red, i18n.Error, i18n.MessageRemindNotFound)
+		return
+	}
+
+	ctx.EditWith(customisation.Green, i18n.TitleReminder, i18n.MessageRemindCancelled, reminder.Message)
+}
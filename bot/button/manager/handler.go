@@ -3,27 +3,30 @@ package manager
 import (
 	"context"
 	"fmt"
-	"github.com/TicketsBot/common/permission"
 	"github.com/TicketsBot/common/premium"
 	"github.com/TicketsBot/common/sentry"
 	"github.com/TicketsBot/worker"
-	"github.com/TicketsBot/worker/bot/blacklist"
 	"github.com/TicketsBot/worker/bot/button"
 	"github.com/TicketsBot/worker/bot/button/registry"
 	cmdcontext "github.com/TicketsBot/worker/bot/command/context"
 	cmdregistry "github.com/TicketsBot/worker/bot/command/registry"
 	"github.com/TicketsBot/worker/bot/customisation"
 	"github.com/TicketsBot/worker/bot/errorcontext"
+	"github.com/TicketsBot/worker/bot/lifecycle"
+	"github.com/TicketsBot/worker/bot/middleware"
+	"github.com/TicketsBot/worker/bot/tracing"
 	"github.com/TicketsBot/worker/bot/utils"
 	"github.com/TicketsBot/worker/config"
 	"github.com/TicketsBot/worker/i18n"
 	"github.com/rxdn/gdl/objects/interaction"
 	"github.com/rxdn/gdl/objects/interaction/component"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"time"
 )
 
 // Returns whether the handler may edit the message
-func HandleInteraction(ctx context.Context, manager *ComponentInteractionManager, worker *worker.Context, data interaction.MessageComponentInteraction, responseCh chan button.Response) bool {
+func HandleInteraction(ctx context.Context, lifecycleManager *lifecycle.Manager, manager *ComponentInteractionManager, worker *worker.Context, data interaction.MessageComponentInteraction, responseCh chan button.Response) bool {
 	// Safety checks - guild interactions only
 	if data.GuildId.Value != 0 && data.Member == nil {
 		return false
@@ -33,6 +36,11 @@ func HandleInteraction(ctx context.Context, manager *ComponentInteractionManager
 		return false
 	}
 
+	ctx, span := startDispatchSpan(ctx, data.InteractionMetadata)
+	defer span.End()
+
+	worker = worker.WithContext(ctx)
+
 	lookupCtx, cancelLookupCtx := context.WithTimeout(ctx, time.Second*2)
 	defer cancelLookupCtx()
 
@@ -68,12 +76,6 @@ func HandleInteraction(ctx context.Context, manager *ComponentInteractionManager
 		return false
 	}
 
-	// Check for guild-wide blacklist
-	if data.GuildId.Value != 0 && blacklist.IsGuildBlacklisted(data.GuildId.Value) {
-		cc.Reply(customisation.Red, i18n.TitleBlacklisted, i18n.MessageBlacklisted)
-		return false
-	}
-
 	// Check not if the context has been cancelled
 	if err := lookupCtx.Err(); err != nil {
 		errorId := sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{
@@ -85,23 +87,6 @@ func HandleInteraction(ctx context.Context, manager *ComponentInteractionManager
 		return false
 	}
 
-	// Check if the user is blacklisted at guild / global level
-	userBlacklisted, err := cc.IsBlacklisted(lookupCtx)
-	if err != nil {
-		errorId := sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{
-			Guild:   data.GuildId.Value,
-			Channel: data.ChannelId,
-		})
-
-		cc.ReplyRaw(customisation.Red, "Error", fmt.Sprintf("An error occurred while processing this request (Error ID `%s`)", errorId))
-		return false
-	}
-
-	if userBlacklisted {
-		cc.Reply(customisation.Red, i18n.TitleBlacklisted, i18n.MessageBlacklisted)
-		return false
-	}
-
 	checkCtx, cancel := context.WithTimeout(ctx, time.Second*2)
 	defer cancel()
 
@@ -112,44 +97,64 @@ func HandleInteraction(ctx context.Context, manager *ComponentInteractionManager
 			return false
 		}
 
-		shouldExecute, canEdit := doPropertiesChecks(checkCtx, data.GuildId.Value, cc, handler.Properties())
-		if shouldExecute {
-			go func() {
+		properties := handler.Properties()
+		if !doPropertiesChecks(data.GuildId.Value, cc, properties) {
+			return false
+		}
+
+		var executed bool
+		manager.middleware.Then(func(*middleware.Invocation) {
+			executed = true
+
+			lifecycleManager.Go(func() {
 				defer close(responseCh)
 
 				cc := cc.(*cmdcontext.ButtonContext)
 
+				spanCtx, span := startHandlerSpan(cc.Context, "button", handler)
+				defer span.End()
+
 				var cancel context.CancelFunc
-				cc.Context, cancel = context.WithTimeout(cc.Context, handler.Properties().Timeout)
+				cc.Context, cancel = context.WithTimeout(spanCtx, properties.Timeout)
 				defer cancel()
 
 				handler.Execute(cc)
-			}()
-		}
+			})
+		})(newInvocation(checkCtx, cc, middleware.InvocationButton, handler, properties))
 
-		return canEdit
+		return executed && properties.HasFlag(registry.CanEdit)
 	case component.ComponentSelectMenu:
 		handler := manager.MatchSelect(data.Data.AsSelectMenu().CustomId)
 		if handler == nil {
 			return false
 		}
 
-		shouldExecute, canEdit := doPropertiesChecks(checkCtx, data.GuildId.Value, cc, handler.Properties())
-		if shouldExecute {
-			go func() {
+		properties := handler.Properties()
+		if !doPropertiesChecks(data.GuildId.Value, cc, properties) {
+			return false
+		}
+
+		var executed bool
+		manager.middleware.Then(func(*middleware.Invocation) {
+			executed = true
+
+			lifecycleManager.Go(func() {
 				defer close(responseCh)
 
 				cc := cc.(*cmdcontext.SelectMenuContext)
 
+				spanCtx, span := startHandlerSpan(cc.Context, "select", handler)
+				defer span.End()
+
 				var cancel context.CancelFunc
-				cc.Context, cancel = context.WithTimeout(cc.Context, handler.Properties().Timeout)
+				cc.Context, cancel = context.WithTimeout(spanCtx, properties.Timeout)
 				defer cancel()
 
 				handler.Execute(cc)
-			}()
-		}
+			})
+		})(newInvocation(checkCtx, cc, middleware.InvocationSelectMenu, handler, properties))
 
-		return canEdit
+		return executed && properties.HasFlag(registry.CanEdit)
 	default:
 		sentry.ErrorWithContext(fmt.Errorf("invalid message component type: %d", data.Data.ComponentType), errorcontext.WorkerErrorContext{
 			Guild:   data.GuildId.Value,
@@ -177,29 +182,47 @@ func getPremiumTier(ctx context.Context, worker *worker.Context, guildId uint64)
 	}
 }
 
-func doPropertiesChecks(ctx context.Context, guildId uint64, cmd cmdregistry.CommandContext, properties registry.Properties) (shouldExecute, canEdit bool) {
-	if properties.PermissionLevel > permission.Everyone {
-		permLevel, err := cmd.UserPermissionLevel(ctx)
-		if err != nil {
-			sentry.ErrorWithContext(err, cmd.ToErrorContext())
-			return false, false
-		}
-
-		if permLevel < properties.PermissionLevel {
-			cmd.Reply(customisation.Red, i18n.Error, i18n.MessageNoPermission)
-			return false, false
-		}
-	}
-
+// doPropertiesChecks checks that the handler can be used where the component was sent. Permission checks are made by
+// the middleware chain.
+func doPropertiesChecks(guildId uint64, cmd cmdregistry.CommandContext, properties registry.Properties) bool {
 	if guildId == 0 && !properties.HasFlag(registry.DMsAllowed) {
 		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageButtonGuildOnly)
-		return false, false
+		return false
 	}
 
 	if guildId != 0 && !properties.HasFlag(registry.GuildAllowed) {
 		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageButtonDMOnly)
-		return false, false
+		return false
 	}
 
-	return true, properties.HasFlag(registry.CanEdit)
+	return true
+}
+
+func newInvocation(
+	ctx context.Context,
+	cmd cmdregistry.InteractionContext,
+	invocationType middleware.InvocationType,
+	handler any,
+	properties registry.Properties,
+) *middleware.Invocation {
+	requirements := middleware.Requirements{
+		PermissionLevel: properties.PermissionLevel,
+		Cooldown:        properties.Cooldown,
+	}
+
+	return middleware.NewInvocation(ctx, cmd, invocationType, fmt.Sprintf("%T", handler), requirements)
+}
+
+// startDispatchSpan starts the span covering the checks made before a component handler is executed
+func startDispatchSpan(ctx context.Context, data interaction.InteractionMetadata) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "component.dispatch",
+		attribute.Int64("discord.interaction_id", int64(data.Id)),
+		attribute.Int64("discord.guild_id", int64(data.GuildId.Value)),
+	)
+}
+
+// startHandlerSpan starts the span covering the execution of a component handler. Handlers run after the initial
+// response has been sent, so this span outlives the dispatch span.
+func startHandlerSpan(ctx context.Context, kind string, handler any) (context.Context, trace.Span) {
+	return tracing.Start(ctx, fmt.Sprintf("%s %T", kind, handler))
 }
@@ -4,6 +4,8 @@ import (
 	"github.com/TicketsBot/worker/bot/button/handlers"
 	"github.com/TicketsBot/worker/bot/button/registry"
 	"github.com/TicketsBot/worker/bot/button/registry/matcher"
+	"github.com/TicketsBot/worker/bot/lifecycle"
+	"github.com/TicketsBot/worker/bot/middleware"
 )
 
 type ComponentInteractionManager struct {
@@ -23,6 +25,8 @@ type ComponentInteractionManager struct {
 	// modal matching engines
 	modalSimpleMatches map[string]registry.ModalHandler
 	modalFuncMatches   map[registry.ModalHandler]matcher.FuncMatchEngine
+
+	middleware middleware.Chain
 }
 
 func NewButtonManager() *ComponentInteractionManager {
@@ -47,11 +51,23 @@ func (m *ComponentInteractionManager) GetCommands() []registry.ButtonHandler {
 	return m.buttonRegistry
 }
 
-func (m *ComponentInteractionManager) RegisterCommands() {
+// Use adds middleware to the end of the chain that every button and select menu handler is run through before it is
+// executed
+func (m *ComponentInteractionManager) Use(mw ...middleware.Middleware) {
+	m.middleware = append(m.middleware, mw...)
+}
+
+func (m *ComponentInteractionManager) Middleware() middleware.Chain {
+	return m.middleware
+}
+
+func (m *ComponentInteractionManager) RegisterCommands(lifecycleManager *lifecycle.Manager) {
 	m.buttonRegistry = append(m.buttonRegistry,
 		new(handlers.AddAdminHandler),
 		new(handlers.AddSupportHandler),
 		new(handlers.CloseHandler),
+		new(handlers.CloseAllCancelHandler),
+		&handlers.CloseAllConfirmHandler{Lifecycle: lifecycleManager},
 		new(handlers.CloseWithReasonModalHandler),
 		new(handlers.ClaimHandler),
 		new(handlers.CloseConfirmHandler),
@@ -64,6 +80,7 @@ func (m *ComponentInteractionManager) RegisterCommands() {
 		new(handlers.PremiumKeyButtonHandler),
 		new(handlers.RateHandler),
 		new(handlers.RedeemVoteCreditsHandler),
+		new(handlers.RemindCancelHandler),
 		new(handlers.ViewStaffHandler),
 		new(handlers.ViewSurveyHandler),
 	)
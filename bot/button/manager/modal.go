@@ -6,14 +6,17 @@ import (
 	"github.com/TicketsBot/common/sentry"
 	"github.com/TicketsBot/worker"
 	"github.com/TicketsBot/worker/bot/button"
+	"github.com/TicketsBot/worker/bot/button/registry"
 	cmdcontext "github.com/TicketsBot/worker/bot/command/context"
 	"github.com/TicketsBot/worker/bot/errorcontext"
+	"github.com/TicketsBot/worker/bot/lifecycle"
+	"github.com/TicketsBot/worker/bot/middleware"
 	"github.com/TicketsBot/worker/config"
 	"github.com/rxdn/gdl/objects/interaction"
 	"time"
 )
 
-func HandleModalInteraction(ctx context.Context, manager *ComponentInteractionManager, worker *worker.Context, data interaction.ModalSubmitInteraction, responseCh chan button.Response) bool {
+func HandleModalInteraction(ctx context.Context, lifecycleManager *lifecycle.Manager, manager *ComponentInteractionManager, worker *worker.Context, data interaction.ModalSubmitInteraction, responseCh chan button.Response) bool {
 	// Safety checks
 	if data.GuildId.Value != 0 && data.Member == nil {
 		return false
@@ -23,6 +26,11 @@ func HandleModalInteraction(ctx context.Context, manager *ComponentInteractionMa
 		return false
 	}
 
+	ctx, span := startDispatchSpan(ctx, data.InteractionMetadata)
+	defer span.End()
+
+	worker = worker.WithContext(ctx)
+
 	lookupCtx, cancelLookupCtx := context.WithTimeout(ctx, time.Second*2)
 	defer cancelLookupCtx()
 
@@ -45,18 +53,34 @@ func HandleModalInteraction(ctx context.Context, manager *ComponentInteractionMa
 		return false
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, handler.Properties().Timeout)
+	properties := handler.Properties()
+	ctx, cancel := context.WithTimeout(ctx, properties.Timeout)
 
 	cc := cmdcontext.NewModalContext(ctx, worker, data, premiumTier, responseCh)
-	shouldExecute, canEdit := doPropertiesChecks(lookupCtx, data.GuildId.Value, cc, handler.Properties())
-	if shouldExecute {
-		go func() {
+	if !doPropertiesChecks(data.GuildId.Value, cc, properties) {
+		cancel()
+		return false
+	}
+
+	var executed bool
+	manager.middleware.Then(func(*middleware.Invocation) {
+		executed = true
+
+		lifecycleManager.Go(func() {
 			defer cancel()
+
+			spanCtx, span := startHandlerSpan(cc.Context, "modal", handler)
+			defer span.End()
+
+			cc.Context = spanCtx
 			handler.Execute(cc)
-		}()
-	} else {
+		})
+	})(newInvocation(lookupCtx, cc, middleware.InvocationModal, handler, properties))
+
+	if !executed {
 		cancel()
+		return false
 	}
 
-	return canEdit
+	return properties.HasFlag(registry.CanEdit)
 }
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"github.com/TicketsBot/worker/bot/dbclient"
 	"github.com/TicketsBot/worker/config"
-	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/rxdn/gdl/cache"
 	"go.uber.org/zap"
@@ -38,7 +37,7 @@ func Connect(logger *zap.Logger) (client cache.PgCache, err error) {
 	)
 
 	// TODO: Sentry
-	cfg.ConnConfig.LogLevel = pgx.LogLevelWarn
+	cfg.ConnConfig.LogLevel = dbclient.LogLevel()
 	cfg.ConnConfig.Logger = dbclient.NewLogAdapter(logger)
 	cfg.ConnConfig.PreferSimpleProtocol = true
 	cfg.ConnConfig.ConnectTimeout = time.Second * 15
@@ -2,6 +2,7 @@ package command
 
 import (
 	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/channel"
 	"github.com/rxdn/gdl/objects/interaction"
 )
 
@@ -12,6 +13,17 @@ type Argument struct {
 	Required            bool
 	InvalidMessage      i18n.MessageId
 	AutoCompleteHandler AutoCompleteHandler
+
+	// Choices are the only values that may be given. They cannot be used with autocomplete.
+	Choices []interaction.ApplicationCommandOptionChoice
+	// MinValue and MaxValue bound integer and number arguments
+	MinValue *float64
+	MaxValue *float64
+	// MinLength and MaxLength bound the length of string arguments
+	MinLength *int
+	MaxLength *int
+	// ChannelTypes are the types of channel that may be given to a channel argument. Any type is allowed if empty.
+	ChannelTypes []channel.ChannelType
 }
 
 type AutoCompleteHandler func(data interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice
@@ -60,6 +72,50 @@ func NewRequiredAutocompleteableArgument(name, description string, argumentType
 	}
 }
 
+// WithChoices returns a copy of the argument that only accepts the given choices
+func (a Argument) WithChoices(choices ...interaction.ApplicationCommandOptionChoice) Argument {
+	a.Choices = choices
+	return a
+}
+
+// WithRange returns a copy of the integer or number argument that only accepts values from min to max, inclusive
+func (a Argument) WithRange(min, max float64) Argument {
+	a.MinValue = &min
+	a.MaxValue = &max
+	return a
+}
+
+// WithMinValue returns a copy of the integer or number argument that only accepts values of at least min
+func (a Argument) WithMinValue(min float64) Argument {
+	a.MinValue = &min
+	return a
+}
+
+// WithMaxValue returns a copy of the integer or number argument that only accepts values of at most max
+func (a Argument) WithMaxValue(max float64) Argument {
+	a.MaxValue = &max
+	return a
+}
+
+// WithLength returns a copy of the string argument that only accepts values from min to max characters long, inclusive
+func (a Argument) WithLength(min, max int) Argument {
+	a.MinLength = &min
+	a.MaxLength = &max
+	return a
+}
+
+// WithMaxLength returns a copy of the string argument that only accepts values of at most max characters
+func (a Argument) WithMaxLength(max int) Argument {
+	a.MaxLength = &max
+	return a
+}
+
+// WithChannelTypes returns a copy of the channel argument that only accepts channels of the given types
+func (a Argument) WithChannelTypes(channelTypes ...channel.ChannelType) Argument {
+	a.ChannelTypes = channelTypes
+	return a
+}
+
 func Arguments(argument ...Argument) []Argument {
 	return argument
 }
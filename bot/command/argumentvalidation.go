@@ -0,0 +1,105 @@
+package command
+
+import (
+	"fmt"
+	"github.com/rxdn/gdl/objects"
+	"github.com/rxdn/gdl/objects/channel"
+	"github.com/rxdn/gdl/objects/interaction"
+	"strconv"
+	"unicode/utf8"
+)
+
+// InvalidArgumentError is returned by the generated command caller when a value breaks the argument's constraints.
+// Discord enforces most constraints itself, so this is mainly seen when the command registration is outdated.
+type InvalidArgumentError struct {
+	Argument Argument
+}
+
+func (e *InvalidArgumentError) Error() string {
+	return fmt.Sprintf("option %s is invalid", e.Argument.Name)
+}
+
+// HasConstraints returns whether the generated command caller needs to validate the argument's values
+func (a Argument) HasConstraints() bool {
+	return len(a.Choices) > 0 || a.MinValue != nil || a.MaxValue != nil || a.MinLength != nil || a.MaxLength != nil ||
+		len(a.ChannelTypes) > 0
+}
+
+func (a Argument) ValidateString(value string) error {
+	length := utf8.RuneCountInString(value)
+	if (a.MinLength != nil && length < *a.MinLength) || (a.MaxLength != nil && length > *a.MaxLength) {
+		return &InvalidArgumentError{Argument: a}
+	}
+
+	return a.validateChoice(value)
+}
+
+func (a Argument) ValidateInteger(value int) error {
+	return a.ValidateNumber(float64(value))
+}
+
+func (a Argument) ValidateNumber(value float64) error {
+	if (a.MinValue != nil && value < *a.MinValue) || (a.MaxValue != nil && value > *a.MaxValue) {
+		return &InvalidArgumentError{Argument: a}
+	}
+
+	return a.validateChoice(value)
+}
+
+// ValidateChannel checks the type of the channel, if Discord included it in the resolved data
+func (a Argument) ValidateChannel(resolved interaction.ResolvedData, channelId uint64) error {
+	if len(a.ChannelTypes) == 0 {
+		return nil
+	}
+
+	ch, ok := resolved.Channels[objects.Snowflake(channelId)]
+	if !ok {
+		return nil
+	}
+
+	for _, channelType := range a.ChannelTypes {
+		if ch.Type == channelType {
+			return nil
+		}
+	}
+
+	return &InvalidArgumentError{Argument: a}
+}
+
+func (a Argument) validateChoice(value interface{}) error {
+	if len(a.Choices) == 0 {
+		return nil
+	}
+
+	for _, choice := range a.Choices {
+		if choiceEquals(choice.Value, value) {
+			return nil
+		}
+	}
+
+	return &InvalidArgumentError{Argument: a}
+}
+
+// choiceEquals compares a choice declared in code with a value received from Discord, which sends all numbers as
+// float64
+func choiceEquals(choice, value interface{}) bool {
+	switch choice := choice.(type) {
+	case int:
+		return float64(choice) == value
+	case int64:
+		return float64(choice) == value
+	default:
+		return choice == value
+	}
+}
+
+// ResolveAttachment looks up an attachment argument, whose value is the ID of the attachment, in the resolved data
+func ResolveAttachment(resolved interaction.ResolvedData, raw string) (channel.Attachment, bool) {
+	id, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return channel.Attachment{}, false
+	}
+
+	attachment, ok := resolved.Attachments[objects.Snowflake(id)]
+	return attachment, ok
+}
@@ -0,0 +1,70 @@
+package command
+
+import (
+	"github.com/rxdn/gdl/objects"
+	"github.com/rxdn/gdl/objects/channel"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestValidateRange(t *testing.T) {
+	arg := NewOptionalArgument("close_delay", "", interaction.OptionTypeInteger, "").WithRange(1, 720)
+	require.True(t, arg.HasConstraints())
+
+	require.NoError(t, arg.ValidateInteger(1))
+	require.NoError(t, arg.ValidateInteger(720))
+	require.Error(t, arg.ValidateInteger(0))
+	require.Error(t, arg.ValidateInteger(721))
+
+	var invalidErr *InvalidArgumentError
+	require.ErrorAs(t, arg.ValidateInteger(-5), &invalidErr)
+	require.Equal(t, "close_delay", invalidErr.Argument.Name)
+
+	require.False(t, NewOptionalArgument("reason", "", interaction.OptionTypeString, "").HasConstraints())
+}
+
+func TestValidateLength(t *testing.T) {
+	arg := NewOptionalArgument("reason", "", interaction.OptionTypeString, "").WithLength(2, 3)
+
+	require.NoError(t, arg.ValidateString("ab"))
+	require.NoError(t, arg.ValidateString("äöü")) // Characters, not bytes
+	require.Error(t, arg.ValidateString("a"))
+	require.Error(t, arg.ValidateString("abcd"))
+}
+
+func TestValidateChoices(t *testing.T) {
+	str := NewRequiredArgument("colour", "", interaction.OptionTypeString, "").WithChoices(
+		interaction.ApplicationCommandOptionChoice{Name: "Red", Value: "red"},
+		interaction.ApplicationCommandOptionChoice{Name: "Blue", Value: "blue"},
+	)
+
+	require.NoError(t, str.ValidateString("red"))
+	require.Error(t, str.ValidateString("green"))
+
+	// Integer choices are declared as ints, but compared with the float64 values that Discord sends
+	integer := NewRequiredArgument("hours", "", interaction.OptionTypeInteger, "").WithChoices(
+		interaction.ApplicationCommandOptionChoice{Name: "One", Value: 1},
+		interaction.ApplicationCommandOptionChoice{Name: "Two", Value: 2},
+	)
+
+	require.NoError(t, integer.ValidateInteger(2))
+	require.Error(t, integer.ValidateInteger(3))
+}
+
+func TestValidateChannel(t *testing.T) {
+	arg := NewRequiredArgument("category", "", interaction.OptionTypeChannel, "").WithChannelTypes(channel.ChannelTypeGuildCategory)
+
+	resolved := interaction.ResolvedData{
+		Channels: map[objects.Snowflake]channel.Channel{
+			1: {Id: 1, Type: channel.ChannelTypeGuildCategory},
+			2: {Id: 2, Type: channel.ChannelTypeGuildText},
+		},
+	}
+
+	require.NoError(t, arg.ValidateChannel(resolved, 1))
+	require.Error(t, arg.ValidateChannel(resolved, 2))
+
+	// Channels that Discord did not resolve are not checked
+	require.NoError(t, arg.ValidateChannel(resolved, 3))
+}
@@ -0,0 +1,17 @@
+package command
+
+import "time"
+
+// Cooldown limits how many times each user can use a command or component in a guild within a sliding window. The
+// zero value means there is no cooldown.
+type Cooldown struct {
+	Uses   int
+	Window time.Duration
+	// Bucket is the name that uses are counted under, if not the command's own name, so that commands which do the
+	// same thing can share a cooldown. A guild's override for the bucket applies to all of them.
+	Bucket string
+}
+
+func (c Cooldown) Enabled() bool {
+	return c.Uses > 0 && c.Window > 0
+}
@@ -6,7 +6,9 @@ import (
 	"github.com/TicketsBot/worker/bot/command"
 	"github.com/TicketsBot/worker/bot/command/registry"
 	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/logic"
 	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/bot/workerdb"
 	"github.com/TicketsBot/worker/i18n"
 	"github.com/elliotchance/orderedmap"
 	"github.com/rxdn/gdl/objects/channel/embed"
@@ -51,6 +53,45 @@ func (c HelpCommand) Execute(ctx registry.CommandContext) {
 		return
 	}
 
+	var overrides map[string][]workerdb.CommandPermissionOverride
+	var roles []uint64
+	if ctx.GuildId() != 0 {
+		settings, err := logic.GetCommandSettings(ctx, ctx.GuildId())
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		overrides = settings.Overrides
+
+		if len(overrides) > 0 {
+			member, err := ctx.Member()
+			if err != nil {
+				ctx.HandleError(err)
+				return
+			}
+
+			roles = member.Roles
+		}
+	}
+
+	// A parent command is shown if the user can run any of its subcommands
+	var canRun func(cmd registry.Command, name string) bool
+	canRun = func(cmd registry.Command, name string) bool {
+		properties := cmd.Properties()
+		if len(properties.Children) == 0 {
+			return logic.CanRunCommand(overrides[name], ctx.GuildId(), ctx.UserId(), roles, permLevel, properties.PermissionLevel)
+		}
+
+		for _, child := range properties.Children {
+			if canRun(child, name+" "+child.Properties().Name) {
+				return true
+			}
+		}
+
+		return false
+	}
+
 	commandIds, err := command.LoadCommandIds(ctx.Worker(), ctx.Worker().BotId)
 	if err != nil {
 		ctx.HandleError(err)
@@ -75,7 +116,7 @@ func (c HelpCommand) Execute(ctx registry.CommandContext) {
 			continue
 		}
 
-		if permLevel >= cmd.Properties().PermissionLevel { // only send commands the user has permissions for
+		if canRun(cmd, properties.Name) { // only send commands the user has permissions for
 			var current []registry.Command
 			if commands, ok := commandCategories.Get(properties.Category); ok {
 				if commands == nil {
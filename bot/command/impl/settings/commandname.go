@@ -0,0 +1,74 @@
+package settings
+
+import (
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"sort"
+	"strings"
+)
+
+func commandNameArgument(reg registry.Registry) command.Argument {
+	return command.NewRequiredAutocompleteableArgument(
+		"name",
+		"The command, including any subcommands, e.g. claim self",
+		interaction.OptionTypeString,
+		i18n.MessagePermissionsInvalidCommand,
+		func(_ interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+			return commandNameChoices(reg, value)
+		},
+	)
+}
+
+// configurableCommands returns the full names of the commands that guilds can set permissions and cooldowns for, sorted
+func configurableCommands(reg registry.Registry) []string {
+	var names []string
+	for name, cmd := range reg.ExecutableCommands() {
+		properties := cmd.Properties()
+		if properties.AdminOnly || properties.HelperOnly {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// findConfigurableCommand normalises the name entered by the user, returning false if it is not a command that guilds
+// can configure
+func findConfigurableCommand(reg registry.Registry, name string) (string, bool) {
+	name = strings.Join(strings.Fields(strings.TrimPrefix(strings.TrimSpace(name), "/")), " ")
+
+	for _, configurable := range configurableCommands(reg) {
+		if strings.EqualFold(configurable, name) {
+			return configurable, true
+		}
+	}
+
+	return "", false
+}
+
+func commandNameChoices(reg registry.Registry, value string) []interaction.ApplicationCommandOptionChoice {
+	value = strings.ToLower(strings.TrimPrefix(value, "/"))
+
+	choices := make([]interaction.ApplicationCommandOptionChoice, 0)
+	for _, name := range configurableCommands(reg) {
+		if !strings.Contains(strings.ToLower(name), value) {
+			continue
+		}
+
+		choices = append(choices, interaction.ApplicationCommandOptionChoice{
+			Name:  name,
+			Value: name,
+		})
+
+		if len(choices) == 25 {
+			break
+		}
+	}
+
+	return choices
+}
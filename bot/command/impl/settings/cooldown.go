@@ -0,0 +1,42 @@
+package settings
+
+import (
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"time"
+)
+
+const (
+	maxCooldownUses   = 100
+	maxCooldownWindow = time.Hour * 24
+)
+
+type CooldownCommand struct {
+	Registry registry.Registry
+}
+
+func (c CooldownCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "cooldown",
+		Description:     i18n.HelpCooldown,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Children: []registry.Command{
+			CooldownViewCommand{Registry: c.Registry},
+			CooldownSetCommand{Registry: c.Registry},
+			CooldownResetCommand{Registry: c.Registry},
+		},
+	}
+}
+
+func (c CooldownCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (CooldownCommand) Execute(ctx registry.CommandContext) {
+	// Cannot call parent command
+}
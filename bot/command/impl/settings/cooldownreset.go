@@ -0,0 +1,62 @@
+package settings
+
+import (
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/logic"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"time"
+)
+
+type CooldownResetCommand struct {
+	Registry registry.Registry
+}
+
+func (c CooldownResetCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "reset",
+		Description:     i18n.HelpCooldownReset,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			commandNameArgument(c.Registry),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c CooldownResetCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (c CooldownResetCommand) Execute(ctx registry.CommandContext, rawName string) {
+	name, ok := findConfigurableCommand(c.Registry, rawName)
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessagePermissionsInvalidCommand, rawName)
+		return
+	}
+
+	deleted, err := dbclient.WorkerDb.CommandCooldowns.Delete(ctx, ctx.GuildId(), name)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if err := logic.InvalidateCommandSettings(ctx, ctx.GuildId()); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !deleted {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageCooldownNotOverridden, name)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleCooldown, i18n.MessageCooldownReset, name)
+}
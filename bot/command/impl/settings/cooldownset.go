@@ -0,0 +1,87 @@
+package settings
+
+import (
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/logic"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/bot/workerdb"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"time"
+)
+
+type CooldownSetCommand struct {
+	Registry registry.Registry
+}
+
+func (c CooldownSetCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "set",
+		Description:     i18n.HelpCooldownSet,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			commandNameArgument(c.Registry),
+			command.NewRequiredArgument("uses", "How many times each user can use the command within the window, or 0 for no cooldown", interaction.OptionTypeInteger, i18n.MessageCooldownInvalidUses).
+				WithRange(0, maxCooldownUses),
+			command.NewOptionalArgument("window", "How long the window is, e.g. 30s or 5m", interaction.OptionTypeString, i18n.MessageCooldownInvalidWindow),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c CooldownSetCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (c CooldownSetCommand) Execute(ctx registry.CommandContext, rawName string, uses int, windowRaw *string) {
+	name, ok := findConfigurableCommand(c.Registry, rawName)
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessagePermissionsInvalidCommand, rawName)
+		return
+	}
+
+	cooldown := workerdb.CommandCooldown{
+		GuildId:     ctx.GuildId(),
+		CommandName: name,
+		Uses:        uses,
+	}
+
+	// The window is not needed to disable the cooldown
+	if uses > 0 {
+		if windowRaw == nil {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageCooldownInvalidWindow, utils.FormatDuration(maxCooldownWindow))
+			return
+		}
+
+		window, err := utils.ParseDuration(*windowRaw)
+		if err != nil || window < time.Second || window > maxCooldownWindow {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageCooldownInvalidWindow, utils.FormatDuration(maxCooldownWindow))
+			return
+		}
+
+		cooldown.Window = window
+	}
+
+	if err := dbclient.WorkerDb.CommandCooldowns.Set(ctx, cooldown); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if err := logic.InvalidateCommandSettings(ctx, ctx.GuildId()); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if uses == 0 {
+		ctx.Reply(customisation.Green, i18n.TitleCooldown, i18n.MessageCooldownDisabled, name)
+	} else {
+		ctx.Reply(customisation.Green, i18n.TitleCooldown, i18n.MessageCooldownSet, name, uses, utils.FormatDuration(cooldown.Window))
+	}
+}
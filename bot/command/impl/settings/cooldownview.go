@@ -0,0 +1,68 @@
+package settings
+
+import (
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"time"
+)
+
+type CooldownViewCommand struct {
+	Registry registry.Registry
+}
+
+func (c CooldownViewCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "view",
+		Description:     i18n.HelpCooldownView,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			commandNameArgument(c.Registry),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c CooldownViewCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (c CooldownViewCommand) Execute(ctx registry.CommandContext, rawName string) {
+	name, ok := findConfigurableCommand(c.Registry, rawName)
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessagePermissionsInvalidCommand, rawName)
+		return
+	}
+
+	override, ok, err := dbclient.WorkerDb.CommandCooldowns.Get(ctx, ctx.GuildId(), name)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if ok {
+		if override.Uses == 0 {
+			ctx.Reply(customisation.Green, i18n.TitleCooldown, i18n.MessageCooldownViewDisabled, name)
+		} else {
+			ctx.Reply(customisation.Green, i18n.TitleCooldown, i18n.MessageCooldownViewOverride, name, override.Uses, utils.FormatDuration(override.Window))
+		}
+
+		return
+	}
+
+	cooldown := c.Registry.ExecutableCommands()[name].Properties().Cooldown
+	if !cooldown.Enabled() {
+		ctx.Reply(customisation.Green, i18n.TitleCooldown, i18n.MessageCooldownViewNone, name)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleCooldown, i18n.MessageCooldownViewDefault, name, cooldown.Uses, utils.FormatDuration(cooldown.Window))
+}
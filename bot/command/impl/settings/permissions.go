@@ -0,0 +1,34 @@
+package settings
+
+import (
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type PermissionsCommand struct {
+	Registry registry.Registry
+}
+
+func (c PermissionsCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "permissions",
+		Description:     i18n.HelpPermissions,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Children: []registry.Command{
+			PermissionsCommandGroup{Registry: c.Registry},
+		},
+	}
+}
+
+func (c PermissionsCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (PermissionsCommand) Execute(ctx registry.CommandContext) {
+	// Cannot call parent command
+}
@@ -0,0 +1,38 @@
+package settings
+
+import (
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"time"
+)
+
+type PermissionsAllowCommand struct {
+	Registry registry.Registry
+}
+
+func (c PermissionsAllowCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "allow",
+		Description:     i18n.HelpPermissionsAllow,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			commandNameArgument(c.Registry),
+			command.NewRequiredArgument("user_or_role", "Role or user to allow to use the command", interaction.OptionTypeMentionable, i18n.MessagePermissionsInvalidTarget),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c PermissionsAllowCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (c PermissionsAllowCommand) Execute(ctx registry.CommandContext, name string, targetId uint64) {
+	setCommandOverride(ctx, c.Registry, name, targetId, true)
+}
@@ -0,0 +1,113 @@
+package settings
+
+import (
+	"fmt"
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/context"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/logic"
+	"github.com/TicketsBot/worker/bot/workerdb"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+// maxCommandPermissionOverrides is the most roles and users that can have an override for a single command
+const maxCommandPermissionOverrides = 25
+
+// PermissionsCommandGroup is the "/permissions command" subcommand group
+type PermissionsCommandGroup struct {
+	Registry registry.Registry
+}
+
+func (c PermissionsCommandGroup) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "command",
+		Description:     i18n.HelpPermissionsCommand,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Children: []registry.Command{
+			PermissionsViewCommand{Registry: c.Registry},
+			PermissionsAllowCommand{Registry: c.Registry},
+			PermissionsDenyCommand{Registry: c.Registry},
+			PermissionsResetCommand{Registry: c.Registry},
+		},
+	}
+}
+
+func (c PermissionsCommandGroup) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (PermissionsCommandGroup) Execute(ctx registry.CommandContext) {
+	// Cannot call parent command
+}
+
+func formatOverrideTarget(targetId uint64, isRole bool) string {
+	if isRole {
+		return fmt.Sprintf("<@&%d>", targetId)
+	}
+
+	return fmt.Sprintf("<@%d>", targetId)
+}
+
+func setCommandOverride(ctx registry.CommandContext, reg registry.Registry, rawName string, targetId uint64, allow bool) {
+	name, ok := findConfigurableCommand(reg, rawName)
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessagePermissionsInvalidCommand, rawName)
+		return
+	}
+
+	mentionableType, valid := context.DetermineMentionableType(ctx, targetId)
+	if !valid {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessagePermissionsInvalidTarget)
+		return
+	}
+
+	overrides, err := dbclient.WorkerDb.CommandPermissionOverrides.GetByCommand(ctx, ctx.GuildId(), name)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	exists := false
+	for _, override := range overrides {
+		if override.TargetId == targetId {
+			exists = true
+			break
+		}
+	}
+
+	if !exists && len(overrides) >= maxCommandPermissionOverrides {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessagePermissionsLimitReached, maxCommandPermissionOverrides, name)
+		return
+	}
+
+	override := workerdb.CommandPermissionOverride{
+		GuildId:     ctx.GuildId(),
+		CommandName: name,
+		TargetId:    targetId,
+		IsRole:      mentionableType == context.MentionableTypeRole,
+		Allow:       allow,
+	}
+
+	if err := dbclient.WorkerDb.CommandPermissionOverrides.Set(ctx, override); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if err := logic.InvalidateCommandSettings(ctx, ctx.GuildId()); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	mention := formatOverrideTarget(targetId, override.IsRole)
+	if allow {
+		ctx.Reply(customisation.Green, i18n.TitlePermissions, i18n.MessagePermissionsAllowed, mention, name)
+	} else {
+		ctx.Reply(customisation.Green, i18n.TitlePermissions, i18n.MessagePermissionsDenied, mention, name)
+	}
+}
@@ -0,0 +1,38 @@
+package settings
+
+import (
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"time"
+)
+
+type PermissionsDenyCommand struct {
+	Registry registry.Registry
+}
+
+func (c PermissionsDenyCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "deny",
+		Description:     i18n.HelpPermissionsDeny,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			commandNameArgument(c.Registry),
+			command.NewRequiredArgument("user_or_role", "Role or user to stop from using the command", interaction.OptionTypeMentionable, i18n.MessagePermissionsInvalidTarget),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c PermissionsDenyCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (c PermissionsDenyCommand) Execute(ctx registry.CommandContext, name string, targetId uint64) {
+	setCommandOverride(ctx, c.Registry, name, targetId, false)
+}
@@ -0,0 +1,88 @@
+package settings
+
+import (
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/context"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/logic"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"time"
+)
+
+type PermissionsResetCommand struct {
+	Registry registry.Registry
+}
+
+func (c PermissionsResetCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "reset",
+		Description:     i18n.HelpPermissionsReset,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			commandNameArgument(c.Registry),
+			command.NewOptionalArgument("user_or_role", "Only remove the override for this role or user", interaction.OptionTypeMentionable, i18n.MessagePermissionsInvalidTarget),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c PermissionsResetCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (c PermissionsResetCommand) Execute(ctx registry.CommandContext, rawName string, targetId *uint64) {
+	name, ok := findConfigurableCommand(c.Registry, rawName)
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessagePermissionsInvalidCommand, rawName)
+		return
+	}
+
+	if targetId == nil {
+		count, err := dbclient.WorkerDb.CommandPermissionOverrides.DeleteAll(ctx, ctx.GuildId(), name)
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		if err := logic.InvalidateCommandSettings(ctx, ctx.GuildId()); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		ctx.Reply(customisation.Green, i18n.TitlePermissions, i18n.MessagePermissionsReset, count, name)
+		return
+	}
+
+	mentionableType, valid := context.DetermineMentionableType(ctx, *targetId)
+	if !valid {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessagePermissionsInvalidTarget)
+		return
+	}
+
+	mention := formatOverrideTarget(*targetId, mentionableType == context.MentionableTypeRole)
+
+	deleted, err := dbclient.WorkerDb.CommandPermissionOverrides.Delete(ctx, ctx.GuildId(), name, *targetId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if err := logic.InvalidateCommandSettings(ctx, ctx.GuildId()); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !deleted {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessagePermissionsNotFound, mention, name)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitlePermissions, i18n.MessagePermissionsResetTarget, mention, name)
+}
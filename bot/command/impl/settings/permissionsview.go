@@ -0,0 +1,69 @@
+package settings
+
+import (
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"strings"
+	"time"
+)
+
+type PermissionsViewCommand struct {
+	Registry registry.Registry
+}
+
+func (c PermissionsViewCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "view",
+		Description:     i18n.HelpPermissionsView,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			commandNameArgument(c.Registry),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c PermissionsViewCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (c PermissionsViewCommand) Execute(ctx registry.CommandContext, rawName string) {
+	name, ok := findConfigurableCommand(c.Registry, rawName)
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessagePermissionsInvalidCommand, rawName)
+		return
+	}
+
+	overrides, err := dbclient.WorkerDb.CommandPermissionOverrides.GetByCommand(ctx, ctx.GuildId(), name)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if len(overrides) == 0 {
+		ctx.Reply(customisation.Green, i18n.TitlePermissions, i18n.MessagePermissionsViewEmpty, name)
+		return
+	}
+
+	lines := make([]string, len(overrides))
+	for i, override := range overrides {
+		var status string
+		if override.Allow {
+			status = "✅"
+		} else {
+			status = "❌"
+		}
+
+		lines[i] = status + " " + formatOverrideTarget(override.TargetId, override.IsRole)
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitlePermissions, i18n.MessagePermissionsView, name, strings.Join(lines, "\n"))
+}
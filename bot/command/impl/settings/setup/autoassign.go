@@ -0,0 +1,89 @@
+package setup
+
+import (
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/impl/tickets"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/workerdb"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"strings"
+	"time"
+)
+
+type AutoAssignSetupCommand struct{}
+
+func (AutoAssignSetupCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "autoassign",
+		Description:     i18n.HelpSetup,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("panel", "The panel to automatically assign tickets for", interaction.OptionTypeInteger, i18n.SetupAutoAssignInvalidPanel, tickets.SwitchPanelCommand{}.AutoCompleteHandler),
+			command.NewOptionalAutocompleteableArgument("strategy", "How to choose who to assign: round_robin, least_loaded or on_call. Leave empty to disable", interaction.OptionTypeString, i18n.SetupAutoAssignInvalidStrategy, AutoAssignSetupCommand{}.AutoCompleteHandler),
+		),
+		InteractionOnly: true,
+		Timeout:         time.Second * 5,
+	}
+}
+
+func (c AutoAssignSetupCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (AutoAssignSetupCommand) Execute(ctx registry.CommandContext, panelId int, strategyName *string) {
+	panel, err := dbclient.Client.Panel.GetById(ctx, panelId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if panel.PanelId == 0 || panel.GuildId != ctx.GuildId() {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupAutoAssignInvalidPanel)
+		return
+	}
+
+	if strategyName == nil {
+		if err := dbclient.WorkerDb.PanelAutoAssign.Delete(ctx, panelId); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupAutoAssignRemoved, panel.Title)
+		return
+	}
+
+	strategy, ok := workerdb.ParseAssignStrategy(*strategyName)
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupAutoAssignInvalidStrategy)
+		return
+	}
+
+	if err := dbclient.WorkerDb.PanelAutoAssign.Set(ctx, panelId, strategy); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupAutoAssignSuccess, panel.Title, strategy.String())
+}
+
+func (AutoAssignSetupCommand) AutoCompleteHandler(_ interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	value = strings.ToLower(value)
+
+	var choices []interaction.ApplicationCommandOptionChoice
+	for _, strategy := range workerdb.AssignStrategies {
+		if strings.Contains(strategy.String(), value) {
+			choices = append(choices, interaction.ApplicationCommandOptionChoice{
+				Name:  strategy.String(),
+				Value: strategy.String(),
+			})
+		}
+	}
+
+	return choices
+}
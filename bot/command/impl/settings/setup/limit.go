@@ -21,7 +21,8 @@ func (LimitSetupCommand) Properties() registry.Properties {
 		PermissionLevel: permission.Admin,
 		Category:        command.Settings,
 		Arguments: command.Arguments(
-			command.NewRequiredArgument("limit", "The maximum amount of tickets a user can have open simultaneously", interaction.OptionTypeInteger, i18n.SetupLimitInvalid),
+			command.NewRequiredArgument("limit", "The maximum amount of tickets a user can have open simultaneously", interaction.OptionTypeInteger, i18n.SetupLimitInvalid).
+				WithRange(1, 10),
 		),
 		Timeout: time.Second * 3,
 	}
@@ -32,11 +33,6 @@ func (c LimitSetupCommand) GetExecutor() interface{} {
 }
 
 func (LimitSetupCommand) Execute(ctx registry.CommandContext, limit int) {
-	if limit < 1 || limit > 10 {
-		ctx.Reply(customisation.Red, i18n.TitleSetup, i18n.SetupLimitInvalid)
-		return
-	}
-
 	if err := dbclient.Client.TicketLimit.Set(ctx, ctx.GuildId(), uint8(limit)); err != nil {
 		ctx.HandleError(err)
 		return
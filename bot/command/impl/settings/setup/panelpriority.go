@@ -0,0 +1,132 @@
+package setup
+
+import (
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/database"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/impl/tickets"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/workerdb"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"strings"
+	"time"
+)
+
+type PanelPrioritySetupCommand struct{}
+
+func (PanelPrioritySetupCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "panelpriority",
+		Description:     i18n.HelpSetup,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("panel", "The panel to set the priority of new tickets for", interaction.OptionTypeInteger, i18n.SetupPanelPriorityInvalidPanel, tickets.SwitchPanelCommand{}.AutoCompleteHandler),
+			command.NewOptionalAutocompleteableArgument("default", "The priority to give new tickets: low, normal, high or urgent", interaction.OptionTypeString, i18n.SetupPriorityInvalid, tickets.PriorityCommand{}.AutoCompleteHandler),
+			command.NewOptionalArgument("form_input", "The label of the form question whose answer sets the priority, if it is a valid priority", interaction.OptionTypeString, i18n.SetupPanelPriorityInvalidInput),
+		),
+		InteractionOnly: true,
+		Timeout:         time.Second * 5,
+	}
+}
+
+func (c PanelPrioritySetupCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+// Execute removes the panel's settings if neither a default priority nor a form input is given
+func (PanelPrioritySetupCommand) Execute(ctx registry.CommandContext, panelId int, defaultName *string, inputLabel *string) {
+	panel, err := dbclient.Client.Panel.GetById(ctx, panelId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if panel.PanelId == 0 || panel.GuildId != ctx.GuildId() {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupPanelPriorityInvalidPanel)
+		return
+	}
+
+	if defaultName == nil && inputLabel == nil {
+		if err := dbclient.WorkerDb.PanelPriority.Delete(ctx, panelId); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupPanelPriorityRemoved, panel.Title)
+		return
+	}
+
+	settings := workerdb.PanelPriority{
+		PanelId: panelId,
+	}
+
+	if defaultName != nil {
+		priority, ok := workerdb.ParsePriority(*defaultName)
+		if !ok {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.SetupPriorityInvalid)
+			return
+		}
+
+		settings.DefaultPriority = &priority
+	}
+
+	var input database.FormInput
+	if inputLabel != nil {
+		var ok bool
+		input, ok, err = findFormInput(ctx, panel, *inputLabel)
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		if !ok {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.SetupPanelPriorityInvalidInput, panel.Title)
+			return
+		}
+
+		settings.FormInputId = &input.Id
+	}
+
+	if err := dbclient.WorkerDb.PanelPriority.Set(ctx, settings); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// Tickets fall back to normal priority if there is no default
+	defaultPriority := workerdb.PriorityNormal
+	if settings.DefaultPriority != nil {
+		defaultPriority = *settings.DefaultPriority
+	}
+
+	if settings.FormInputId == nil {
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupPanelPrioritySuccess, panel.Title, defaultPriority.String())
+	} else {
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupPanelPrioritySuccessInput, panel.Title, input.Label, defaultPriority.String())
+	}
+}
+
+// findFormInput looks up a question on the panel's form by its label, ignoring case
+func findFormInput(ctx registry.CommandContext, panel database.Panel, label string) (database.FormInput, bool, error) {
+	if panel.FormId == nil {
+		return database.FormInput{}, false, nil
+	}
+
+	inputs, err := dbclient.Client.FormInput.GetInputs(ctx, *panel.FormId)
+	if err != nil {
+		return database.FormInput{}, false, err
+	}
+
+	label = strings.TrimSpace(label)
+	for _, input := range inputs {
+		if strings.EqualFold(input.Label, label) {
+			return input, true, nil
+		}
+	}
+
+	return database.FormInput{}, false, nil
+}
@@ -0,0 +1,80 @@
+package setup
+
+import (
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/impl/tickets"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/workerdb"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/channel"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/rxdn/gdl/rest/request"
+	"time"
+)
+
+type PrioritySetupCommand struct{}
+
+func (PrioritySetupCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "priority",
+		Description:     i18n.HelpSetup,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("priority", "The priority to configure: low, normal, high or urgent", interaction.OptionTypeString, i18n.SetupPriorityInvalid, tickets.PriorityCommand{}.AutoCompleteHandler),
+			command.NewOptionalArgument("category", "The category that tickets with this priority should be moved to. Leave empty to stop moving them", interaction.OptionTypeChannel, i18n.SetupPriorityInvalidCategory).
+				WithChannelTypes(channel.ChannelTypeGuildCategory),
+		),
+		InteractionOnly: true,
+		Timeout:         time.Second * 5,
+	}
+}
+
+func (c PrioritySetupCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (PrioritySetupCommand) Execute(ctx registry.CommandContext, priorityName string, categoryId *uint64) {
+	priority, ok := workerdb.ParsePriority(priorityName)
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupPriorityInvalid)
+		return
+	}
+
+	if categoryId == nil {
+		if err := dbclient.WorkerDb.PriorityCategories.Delete(ctx, ctx.GuildId(), priority); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupPriorityRemoved, priority.String())
+		return
+	}
+
+	ch, err := ctx.Worker().GetChannel(*categoryId)
+	if err != nil {
+		if restError, ok := err.(request.RestError); ok && restError.IsClientError() {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.SetupPriorityInvalidCategory)
+		} else {
+			ctx.HandleError(err)
+		}
+
+		return
+	}
+
+	if ch.Type != channel.ChannelTypeGuildCategory || ch.GuildId != ctx.GuildId() {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupPriorityInvalidCategory)
+		return
+	}
+
+	if err := dbclient.WorkerDb.PriorityCategories.Set(ctx, ctx.GuildId(), priority, *categoryId); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupPrioritySuccess, priority.String(), *categoryId)
+}
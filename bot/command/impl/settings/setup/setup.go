@@ -20,9 +20,14 @@ func (SetupCommand) Properties() registry.Properties {
 		Category:        command.Settings,
 		Children: []registry.Command{
 			AutoSetupCommand{},
+			AutoAssignSetupCommand{},
 			LimitSetupCommand{},
 			TranscriptsSetupCommand{},
 			ThreadsSetupCommand{},
+			PrioritySetupCommand{},
+			PanelPrioritySetupCommand{},
+			SlaSetupCommand{},
+			SnoozeSetupCommand{},
 		},
 	}
 }
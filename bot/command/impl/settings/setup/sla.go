@@ -0,0 +1,90 @@
+package setup
+
+import (
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/impl/tickets"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/bot/workerdb"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"time"
+)
+
+type SlaSetupCommand struct{}
+
+func (SlaSetupCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "sla",
+		Description:     i18n.HelpSetup,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("panel", "The panel to set response targets for", interaction.OptionTypeInteger, i18n.SetupSlaInvalidPanel, tickets.SwitchPanelCommand{}.AutoCompleteHandler),
+			command.NewOptionalArgument("first_response", "Minutes that staff have to send their first response", interaction.OptionTypeInteger, i18n.SetupSlaInvalidTime),
+			command.NewOptionalArgument("resolution", "Minutes that staff have to close the ticket", interaction.OptionTypeInteger, i18n.SetupSlaInvalidTime),
+			command.NewOptionalArgument("escalation_role", "The role to ping when a ticket is about to miss a target. Defaults to on-call staff", interaction.OptionTypeRole, i18n.MessageInvalidArgument),
+		),
+		InteractionOnly: true,
+		Timeout:         time.Second * 5,
+	}
+}
+
+func (c SlaSetupCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (SlaSetupCommand) Execute(ctx registry.CommandContext, panelId int, firstResponse, resolution *int, escalationRoleId *uint64) {
+	panel, err := dbclient.Client.Panel.GetById(ctx, panelId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if panel.PanelId == 0 || panel.GuildId != ctx.GuildId() {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupSlaInvalidPanel)
+		return
+	}
+
+	// Setting no targets removes the SLA
+	if firstResponse == nil && resolution == nil {
+		if err := dbclient.WorkerDb.PanelSla.Delete(ctx, panelId); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupSlaRemoved, panel.Title)
+		return
+	}
+
+	if (firstResponse != nil && *firstResponse <= 0) || (resolution != nil && *resolution <= 0) {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupSlaInvalidTime)
+		return
+	}
+
+	sla := workerdb.PanelSla{
+		PanelId:          panelId,
+		FirstResponse:    minutesToDuration(firstResponse),
+		Resolution:       minutesToDuration(resolution),
+		EscalationRoleId: escalationRoleId,
+	}
+
+	if err := dbclient.WorkerDb.PanelSla.Set(ctx, sla); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupSlaSuccess, panel.Title)
+}
+
+func minutesToDuration(minutes *int) *time.Duration {
+	if minutes == nil {
+		return nil
+	}
+
+	return utils.Ptr(time.Duration(*minutes) * time.Minute)
+}
@@ -0,0 +1,71 @@
+package setup
+
+import (
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/channel"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/rxdn/gdl/rest/request"
+	"time"
+)
+
+type SnoozeSetupCommand struct{}
+
+func (SnoozeSetupCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "snooze",
+		Description:     i18n.HelpSetup,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewOptionalArgument("category", "The category that snoozed tickets should be moved to. Leave empty to stop moving them", interaction.OptionTypeChannel, i18n.SetupSnoozeInvalidCategory).
+				WithChannelTypes(channel.ChannelTypeGuildCategory),
+		),
+		InteractionOnly: true,
+		Timeout:         time.Second * 5,
+	}
+}
+
+func (c SnoozeSetupCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (SnoozeSetupCommand) Execute(ctx registry.CommandContext, categoryId *uint64) {
+	if categoryId == nil {
+		if err := dbclient.WorkerDb.SnoozeCategory.Delete(ctx, ctx.GuildId()); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupSnoozeRemoved)
+		return
+	}
+
+	ch, err := ctx.Worker().GetChannel(*categoryId)
+	if err != nil {
+		if restError, ok := err.(request.RestError); ok && restError.IsClientError() {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.SetupSnoozeInvalidCategory)
+		} else {
+			ctx.HandleError(err)
+		}
+
+		return
+	}
+
+	if ch.Type != channel.ChannelTypeGuildCategory || ch.GuildId != ctx.GuildId() {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupSnoozeInvalidCategory)
+		return
+	}
+
+	if err := dbclient.WorkerDb.SnoozeCategory.Set(ctx, ctx.GuildId(), *categoryId); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupSnoozeSuccess, *categoryId)
+}
@@ -23,7 +23,8 @@ func (ThreadsSetupCommand) Properties() registry.Properties {
 		Category:        command.Settings,
 		Arguments: command.Arguments(
 			command.NewRequiredArgument("use_threads", "Whether or not private threads should be used for ticket", interaction.OptionTypeBoolean, "infallible"),
-			command.NewOptionalArgument("ticket_notification_channel", "The channel that ticket open notifications should be sent to", interaction.OptionTypeChannel, "infallible"),
+			command.NewOptionalArgument("ticket_notification_channel", "The channel that ticket open notifications should be sent to", interaction.OptionTypeChannel, i18n.SetupThreadsNotificationChannelType).
+				WithChannelTypes(channel.ChannelTypeGuildText),
 		),
 		InteractionOnly: true,
 		Timeout:         time.Second * 5,
@@ -41,17 +42,6 @@ func (ThreadsSetupCommand) Execute(ctx registry.CommandContext, useThreads bool,
 			return
 		}
 
-		ch, err := ctx.Worker().GetChannel(*channelId)
-		if err != nil {
-			ctx.HandleError(err)
-			return
-		}
-
-		if ch.Type != channel.ChannelTypeGuildText {
-			ctx.Reply(customisation.Red, i18n.Error, i18n.SetupThreadsNotificationChannelType)
-			return
-		}
-
 		if err := dbclient.Client.Settings.EnableThreads(ctx, ctx.GuildId(), *channelId); err != nil {
 			ctx.HandleError(err)
 			return
@@ -9,6 +9,7 @@ import (
 	"github.com/TicketsBot/worker/bot/customisation"
 	"github.com/TicketsBot/worker/bot/dbclient"
 	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/bot/workerdb"
 	"github.com/TicketsBot/worker/i18n"
 	"github.com/getsentry/sentry-go"
 	"github.com/jedib0t/go-pretty/v6/table"
@@ -111,6 +112,16 @@ func (StatsServerCommand) Execute(ctx registry.CommandContext) {
 		return
 	})
 
+	// SLA breaches
+	var slaBreaches workerdb.SlaBreachCounts
+	group.Go(func() (err error) {
+		span := sentry.StartSpan(span.Context(), "GetSlaBreachCounts")
+		defer span.Finish()
+
+		slaBreaches, err = dbclient.WorkerDb.SlaBreaches.GetCounts(ctx, ctx.GuildId())
+		return
+	})
+
 	// tickets per day
 	var ticketVolumeTable string
 	group.Go(func() error {
@@ -157,6 +168,9 @@ func (StatsServerCommand) Execute(ctx registry.CommandContext) {
 		AddField("Average Ticket Duration (Total)", formatNullableTime(ticketDuration.AllTime), true).
 		AddField("Average Ticket Duration (Monthly)", formatNullableTime(ticketDuration.Monthly), true).
 		AddField("Average Ticket Duration (Weekly)", formatNullableTime(ticketDuration.Weekly), true).
+		AddField("SLA Breaches (Total)", strconv.FormatUint(slaBreaches.AllTime, 10), true).
+		AddField("SLA Breaches (Monthly)", strconv.FormatUint(slaBreaches.Monthly, 10), true).
+		AddField("SLA Breaches (Weekly)", strconv.FormatUint(slaBreaches.Weekly, 10), true).
 		AddField("Ticket Volume", fmt.Sprintf("```\n%s\n```", ticketVolumeTable), false)
 
 	_, _ = ctx.ReplyWith(command.NewEphemeralEmbedMessageResponse(msgEmbed))
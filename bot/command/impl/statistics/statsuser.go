@@ -34,6 +34,7 @@ func (StatsUserCommand) Properties() registry.Properties {
 		),
 		DefaultEphemeral: true,
 		Timeout:          time.Second * 30,
+		Cooldown:         command.Cooldown{Uses: 2, Window: time.Minute},
 	}
 }
 
@@ -150,7 +151,8 @@ func (StatsUserCommand) Execute(ctx registry.CommandContext, userId uint64) {
 		var weeklyAR, monthlyAR, totalAR *time.Duration
 		var weeklyAnsweredTickets, monthlyAnsweredTickets, totalAnsweredTickets,
 			weeklyTotalTickets, monthlyTotalTickets, totalTotalTickets,
-			weeklyClaimedTickets, monthlyClaimedTickets, totalClaimedTickets int
+			weeklyClaimedTickets, monthlyClaimedTickets, totalClaimedTickets,
+			weeklyCoClaimedTickets, monthlyCoClaimedTickets, totalCoClaimedTickets int
 
 		// totalAR
 		group.Go(func() (err error) {
@@ -260,11 +262,40 @@ func (StatsUserCommand) Execute(ctx registry.CommandContext, userId uint64) {
 			return
 		})
 
+		// Co-claimers are credited for the tickets they worked on too
+		group.Go(func() (err error) {
+			span := sentry.StartSpan(span.Context(), "GetCoClaimedSinceCount_Weekly")
+			defer span.Finish()
+
+			weeklyCoClaimedTickets, err = dbclient.WorkerDb.TicketCoClaimers.GetCoClaimedSinceCount(ctx, ctx.GuildId(), userId, time.Hour*24*7)
+			return
+		})
+
+		group.Go(func() (err error) {
+			span := sentry.StartSpan(span.Context(), "GetCoClaimedSinceCount_Monthly")
+			defer span.Finish()
+
+			monthlyCoClaimedTickets, err = dbclient.WorkerDb.TicketCoClaimers.GetCoClaimedSinceCount(ctx, ctx.GuildId(), userId, time.Hour*24*28)
+			return
+		})
+
+		group.Go(func() (err error) {
+			span := sentry.StartSpan(span.Context(), "GetCoClaimedCount")
+			defer span.Finish()
+
+			totalCoClaimedTickets, err = dbclient.WorkerDb.TicketCoClaimers.GetCoClaimedCount(ctx, ctx.GuildId(), userId)
+			return
+		})
+
 		if err := group.Wait(); err != nil {
 			ctx.HandleError(err)
 			return
 		}
 
+		weeklyClaimedTickets += weeklyCoClaimedTickets
+		monthlyClaimedTickets += monthlyCoClaimedTickets
+		totalClaimedTickets += totalCoClaimedTickets
+
 		var permissionLevel string
 		if permLevel == permission.Admin {
 			permissionLevel = "Admin"
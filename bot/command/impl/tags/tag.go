@@ -19,6 +19,9 @@ import (
 	"time"
 )
 
+// tagCooldown is shared with the tag alias commands, which count towards the same bucket as /tag
+var tagCooldown = command.Cooldown{Uses: 3, Window: time.Second * 15, Bucket: "tag"}
+
 type TagCommand struct {
 }
 
@@ -33,7 +36,8 @@ func (c TagCommand) Properties() registry.Properties {
 		Arguments: command.Arguments(
 			command.NewRequiredAutocompleteableArgument("id", "The ID of the tag to be sent to the channel", interaction.OptionTypeString, i18n.MessageTagInvalidArguments, c.AutoCompleteHandler),
 		),
-		Timeout: time.Second * 5,
+		Timeout:  time.Second * 5,
+		Cooldown: tagCooldown,
 	}
 }
 
@@ -36,6 +36,7 @@ func (c TagAliasCommand) Properties() registry.Properties {
 		PermissionLevel: permission.Everyone,
 		Category:        command.Tags,
 		Timeout:         time.Second * 5,
+		Cooldown:        tagCooldown,
 	}
 }
 
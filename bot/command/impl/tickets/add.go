@@ -1,16 +1,21 @@
 package tickets
 
 import (
+	"fmt"
 	permcache "github.com/TicketsBot/common/permission"
 	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/context"
 	"github.com/TicketsBot/worker/bot/command/registry"
 	"github.com/TicketsBot/worker/bot/constants"
 	"github.com/TicketsBot/worker/bot/customisation"
 	"github.com/TicketsBot/worker/bot/dbclient"
 	"github.com/TicketsBot/worker/bot/logic"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/bot/workerdb"
 	"github.com/TicketsBot/worker/i18n"
 	"github.com/rxdn/gdl/objects/interaction"
 	"github.com/rxdn/gdl/rest/request"
+	"time"
 )
 
 type AddCommand struct {
@@ -24,7 +29,8 @@ func (AddCommand) Properties() registry.Properties {
 		PermissionLevel: permcache.Everyone,
 		Category:        command.Tickets,
 		Arguments: command.Arguments(
-			command.NewRequiredArgument("user", "User to add to the ticket", interaction.OptionTypeUser, i18n.MessageAddNoMembers),
+			command.NewRequiredArgument("user_or_role", "User or role to add to the ticket", interaction.OptionTypeMentionable, i18n.MessageAddNoMembers),
+			command.NewOptionalArgument("duration", "How long to add them for, e.g. 1h or 2d. Leave empty to add them until the ticket closes", interaction.OptionTypeString, i18n.MessageAddInvalidDuration),
 		),
 		Timeout: constants.TimeoutOpenTicket,
 	}
@@ -34,7 +40,7 @@ func (c AddCommand) GetExecutor() interface{} {
 	return c.Execute
 }
 
-func (AddCommand) Execute(ctx registry.CommandContext, userId uint64) {
+func (AddCommand) Execute(ctx registry.CommandContext, id uint64, durationRaw *string) {
 	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
 	if err != nil {
 		ctx.HandleError(err)
@@ -59,19 +65,140 @@ func (AddCommand) Execute(ctx registry.CommandContext, userId uint64) {
 		return
 	}
 
-	// Add user to ticket in DB
-	if err := dbclient.Client.TicketMembers.Add(ctx, ctx.GuildId(), ticket.Id, userId); err != nil {
+	mentionableType, valid := context.DetermineMentionableType(ctx, id)
+	if !valid {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageAddNoMembers)
+		return
+	}
+
+	var expiresAt *time.Time
+	if durationRaw != nil {
+		duration, err := utils.ParseDuration(*durationRaw)
+		if err != nil || duration <= 0 {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageAddInvalidDuration)
+			return
+		}
+
+		if duration > logic.MaxTemporaryAccessDuration {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageAddDurationTooLong, utils.FormatTime(logic.MaxTemporaryAccessDuration))
+			return
+		}
+
+		expiresAt = utils.Ptr(time.Now().Add(duration))
+	}
+
+	var access *workerdb.TicketTemporaryAccess
+	if expiresAt != nil {
+		access = &workerdb.TicketTemporaryAccess{
+			GuildId:   ticket.GuildId,
+			TicketId:  ticket.Id,
+			TargetId:  id,
+			IsRole:    mentionableType == context.MentionableTypeRole,
+			AddedBy:   ctx.UserId(),
+			ExpiresAt: *expiresAt,
+		}
+
+		// Capture the access the user or role already has before adding them, so that expiry only undoes this /add
+		if err := logic.CapturePreviousAccess(ctx, ctx, ticket, access); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+	}
+
+	var mention string
+	switch mentionableType {
+	case context.MentionableTypeUser:
+		if !addUser(ctx, ticket.IsThread, *ticket.ChannelId, ticket.Id, id) {
+			return
+		}
+
+		mention = fmt.Sprintf("<@%d>", id)
+	case context.MentionableTypeRole:
+		// Roles can give many members access at once, so only staff can add them
+		if permissionLevel == permcache.Everyone {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageAddRoleNoPermission)
+			return
+		}
+
+		if id == ctx.GuildId() {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageAddRoleEveryone)
+			return
+		}
+
+		if ticket.IsThread {
+			members, err := logic.GetCachedRoleMembers(ctx, ctx, id)
+			if err != nil {
+				ctx.HandleError(err)
+				return
+			}
+
+			if len(members) > logic.MaxThreadRoleMembers {
+				ctx.Reply(customisation.Red, i18n.Error, i18n.MessageAddRoleTooManyMembers, logic.MaxThreadRoleMembers)
+				return
+			}
+		}
+
+		addedUserIds, err := logic.AddRoleToTicket(ctx, ctx, ticket, id)
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		// Remember who was put in the thread, on top of anyone added by an earlier /add that has not expired yet, so
+		// that only they are removed when the access expires
+		if access != nil {
+			for _, userId := range addedUserIds {
+				if !utils.Contains(access.AddedUserIds, userId) {
+					access.AddedUserIds = append(access.AddedUserIds, userId)
+				}
+			}
+		}
+
+		mention = fmt.Sprintf("<@&%d>", id)
+	default:
+		ctx.HandleError(fmt.Errorf("unknown mentionable type: %d", mentionableType))
+		return
+	}
+
+	if access == nil {
+		// Adding someone again without a duration makes any earlier temporary access permanent
+		if err := logic.ClearAccessExpiry(ctx, ticket.GuildId, ticket.Id, id); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		if mentionableType == context.MentionableTypeRole {
+			ctx.ReplyPermanent(customisation.Green, i18n.TitleAdd, i18n.MessageAddRoleSuccess, id, *ticket.ChannelId)
+		} else {
+			ctx.ReplyPermanent(customisation.Green, i18n.TitleAdd, i18n.MessageAddSuccess, id, *ticket.ChannelId)
+		}
+
+		return
+	}
+
+	if err := logic.ScheduleAccessExpiry(ctx, *access); err != nil {
 		ctx.HandleError(err)
 		return
 	}
 
-	if ticket.IsThread {
-		if err := ctx.Worker().AddThreadMember(*ticket.ChannelId, userId); err != nil {
+	ctx.ReplyPermanent(customisation.Green, i18n.TitleAdd, i18n.MessageAddTemporarySuccess, mention, *ticket.ChannelId, fmt.Sprintf("<t:%d:R>", expiresAt.Unix()))
+}
+
+// addUser returns false if the user could not be added, in which case a reply has already been sent
+func addUser(ctx registry.CommandContext, isThread bool, channelId uint64, ticketId int, userId uint64) bool {
+	// Add user to ticket in DB
+	if err := dbclient.Client.TicketMembers.Add(ctx, ctx.GuildId(), ticketId, userId); err != nil {
+		ctx.HandleError(err)
+		return false
+	}
+
+	if isThread {
+		if err := ctx.Worker().AddThreadMember(channelId, userId); err != nil {
 			if err, ok := err.(request.RestError); ok && err.ApiError.Message == "Missing Access" {
 				ch, err := ctx.Channel()
 				if err != nil {
 					ctx.HandleError(err)
-					return
+					return false
 				}
 
 				ctx.Reply(customisation.Red, i18n.Error, i18n.MessageOpenCantSeeParentChannel, userId, ch.ParentId.Value)
@@ -79,23 +206,22 @@ func (AddCommand) Execute(ctx registry.CommandContext, userId uint64) {
 				ctx.HandleError(err)
 			}
 
-			return
+			return false
 		}
 	} else {
 		// Build permissions
 		additionalPermissions, err := dbclient.Client.TicketPermissions.Get(ctx, ctx.GuildId())
 		if err != nil {
 			ctx.HandleError(err)
-			return
+			return false
 		}
 
-		// ticket.ChannelId cannot be nil, as we get by channel id
 		data := logic.BuildUserOverwrite(userId, additionalPermissions)
-		if err := ctx.Worker().EditChannelPermissions(*ticket.ChannelId, data); err != nil {
+		if err := ctx.Worker().EditChannelPermissions(channelId, data); err != nil {
 			ctx.HandleError(err)
-			return
+			return false
 		}
 	}
 
-	ctx.ReplyPermanent(customisation.Green, i18n.TitleAdd, i18n.MessageAddSuccess, userId, *ticket.ChannelId)
+	return true
 }
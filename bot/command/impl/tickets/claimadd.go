@@ -0,0 +1,102 @@
+package tickets
+
+import (
+	"fmt"
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/constants"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/logic"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type ClaimAddCommand struct {
+}
+
+func (ClaimAddCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "add",
+		Description:     i18n.HelpClaimAdd,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Support,
+		Category:        command.Tickets,
+		Arguments: command.Arguments(
+			command.NewRequiredArgument("user", "Support representative to claim the ticket alongside the claimer", interaction.OptionTypeUser, i18n.MessageInvalidUser),
+		),
+		Timeout: constants.TimeoutOpenTicket,
+	}
+}
+
+func (c ClaimAddCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (ClaimAddCommand) Execute(ctx registry.CommandContext, userId uint64) {
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// Verify this is a ticket channel
+	if ticket.UserId == 0 || ticket.ChannelId == nil {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	if ticket.IsThread {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageClaimThread)
+		return
+	}
+
+	// Only those already working on the ticket can bring in others
+	hasPermission, err := logic.HasPermissionForTicket(ctx, ctx.Worker(), ticket, ctx.UserId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !hasPermission {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageClaimNoPermission)
+		return
+	}
+
+	member, err := ctx.Worker().GetGuildMember(ctx.GuildId(), userId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	permissionLevel, err := permission.GetPermissionLevel(ctx, utils.ToRetriever(ctx.Worker()), member, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if permissionLevel < permission.Support {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageInvalidUser)
+		return
+	}
+
+	claimers, err := logic.GetClaimers(ctx, ctx.GuildId(), ticket.Id)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if utils.Contains(claimers, userId) {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageCoClaimAlreadyClaimed, fmt.Sprintf("<@%d>", userId))
+		return
+	}
+
+	if err := logic.AddCoClaimer(ctx, ctx, ticket, userId); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.ReplyPermanent(customisation.Green, i18n.TitleClaimed, i18n.MessageCoClaimAdded, fmt.Sprintf("<@%d>", userId))
+}
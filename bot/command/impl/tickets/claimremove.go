@@ -0,0 +1,86 @@
+package tickets
+
+import (
+	"fmt"
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/constants"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/logic"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type ClaimRemoveCommand struct {
+}
+
+func (ClaimRemoveCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "remove",
+		Description:     i18n.HelpClaimRemove,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Support,
+		Category:        command.Tickets,
+		Arguments: command.Arguments(
+			command.NewRequiredArgument("user", "Co-claimer to remove from the ticket", interaction.OptionTypeUser, i18n.MessageInvalidUser),
+		),
+		Timeout: constants.TimeoutOpenTicket,
+	}
+}
+
+func (c ClaimRemoveCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (ClaimRemoveCommand) Execute(ctx registry.CommandContext, userId uint64) {
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// Verify this is a ticket channel
+	if ticket.UserId == 0 || ticket.ChannelId == nil {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	if ticket.IsThread {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageClaimThread)
+		return
+	}
+
+	isCoClaimer, err := dbclient.WorkerDb.TicketCoClaimers.IsCoClaimer(ctx, ctx.GuildId(), ticket.Id, userId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !isCoClaimer {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageCoClaimNotCoClaimer, fmt.Sprintf("<@%d>", userId))
+		return
+	}
+
+	// Co-claimers may always step back from a ticket themselves
+	if userId != ctx.UserId() {
+		hasPermission, err := logic.HasPermissionForTicket(ctx, ctx.Worker(), ticket, ctx.UserId())
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		if !hasPermission {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageClaimNoPermission)
+			return
+		}
+	}
+
+	if err := logic.RemoveCoClaimer(ctx, ctx, ticket, userId); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.ReplyPermanent(customisation.Green, i18n.TitleClaimed, i18n.MessageCoClaimRemoved, fmt.Sprintf("<@%d>", userId))
+}
@@ -0,0 +1,67 @@
+package tickets
+
+import (
+	"fmt"
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/constants"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/logic"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/channel"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type ClaimSelfCommand struct {
+}
+
+func (ClaimSelfCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "self",
+		Description:     i18n.HelpClaim,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Support,
+		Category:        command.Tickets,
+		Timeout:         constants.TimeoutOpenTicket,
+	}
+}
+
+func (c ClaimSelfCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (ClaimSelfCommand) Execute(ctx registry.CommandContext) {
+	// Get ticket struct
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// Verify this is a ticket channel
+	if ticket.UserId == 0 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	// Check if thread
+	ch, err := ctx.Worker().GetChannel(ctx.ChannelId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if ch.Type == channel.ChannelTypeGuildPrivateThread {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageClaimThread)
+		return
+	}
+
+	if err := logic.ClaimTicket(ctx, ctx, ticket, ctx.UserId()); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.ReplyPermanent(customisation.Green, i18n.TitleClaimed, i18n.MessageClaimed, fmt.Sprintf("<@%d>", ctx.UserId()))
+}
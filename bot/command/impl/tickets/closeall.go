@@ -0,0 +1,142 @@
+package tickets
+
+import (
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/logic"
+	"github.com/TicketsBot/worker/bot/redis"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/rxdn/gdl/objects/interaction/component"
+	"time"
+)
+
+type CloseAllCommand struct {
+}
+
+func (CloseAllCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "closeall",
+		Description:     i18n.HelpCloseAll,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Tickets,
+		Arguments: command.Arguments(
+			command.NewOptionalAutocompleteableArgument("panel", "Only close tickets opened from this panel", interaction.OptionTypeInteger, i18n.MessageCloseAllInvalidPanel, SwitchPanelCommand{}.AutoCompleteHandler),
+			command.NewOptionalArgument("opened_before", "Only close tickets opened more than this long ago, e.g. 3d", interaction.OptionTypeString, i18n.MessageCloseAllInvalidDuration),
+			command.NewOptionalArgument("opened_after", "Only close tickets opened less than this long ago, e.g. 12h", interaction.OptionTypeString, i18n.MessageCloseAllInvalidDuration),
+			command.NewOptionalArgument("inactive_hours", "Only close tickets without a message for this many hours", interaction.OptionTypeInteger, i18n.MessageCloseAllInvalidDuration),
+			command.NewOptionalArgument("unclaimed_only", "Only close tickets that have not been claimed", interaction.OptionTypeBoolean, i18n.MessageInvalidArgument),
+			command.NewOptionalArgument("user", "Only close tickets opened by this user", interaction.OptionTypeUser, i18n.MessageInvalidUser),
+			command.NewOptionalArgument("reason", "The reason the tickets were closed", interaction.OptionTypeString, i18n.MessageInvalidArgument),
+		),
+		DefaultEphemeral: true,
+		InteractionOnly:  true,
+		Timeout:          time.Second * 10,
+	}
+}
+
+func (c CloseAllCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (CloseAllCommand) Execute(
+	ctx registry.CommandContext,
+	panelId *int,
+	openedBeforeRaw, openedAfterRaw *string,
+	inactiveHours *int,
+	unclaimedOnly *bool,
+	openerId *uint64,
+	reason *string,
+) {
+	filter := logic.CloseAllFilter{
+		PanelId:       panelId,
+		UnclaimedOnly: unclaimedOnly != nil && *unclaimedOnly,
+		OpenerId:      openerId,
+	}
+
+	if panelId != nil {
+		panel, err := dbclient.Client.Panel.GetById(ctx, *panelId)
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		if panel.PanelId == 0 || panel.GuildId != ctx.GuildId() {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageCloseAllInvalidPanel)
+			return
+		}
+	}
+
+	now := time.Now()
+	for _, opt := range []struct {
+		raw  *string
+		dest **time.Time
+	}{{openedBeforeRaw, &filter.OpenedBefore}, {openedAfterRaw, &filter.OpenedAfter}} {
+		if opt.raw == nil {
+			continue
+		}
+
+		duration, err := utils.ParseDuration(*opt.raw)
+		if err != nil || duration <= 0 {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageCloseAllInvalidDuration)
+			return
+		}
+
+		*opt.dest = utils.Ptr(now.Add(-duration))
+	}
+
+	if inactiveHours != nil {
+		if *inactiveHours <= 0 {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageCloseAllInvalidDuration)
+			return
+		}
+
+		filter.InactiveFor = utils.Ptr(time.Duration(*inactiveHours) * time.Hour)
+	}
+
+	tickets, err := logic.FindTicketsToClose(ctx, ctx.GuildId(), filter)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if len(tickets) == 0 {
+		ctx.Reply(customisation.Red, i18n.TitleCloseAll, i18n.MessageCloseAllNone)
+		return
+	}
+
+	requestId := utils.RandString(16)
+	request := logic.CloseAllRequest{
+		UserId: ctx.UserId(),
+		Filter: filter,
+		Reason: reason,
+	}
+
+	if err := redis.StoreCloseAllRequest(ctx, requestId, request); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	buttons := component.BuildActionRow(
+		component.BuildButton(component.Button{
+			Label:    ctx.GetMessage(i18n.Confirm),
+			CustomId: "closeall_confirm_" + requestId,
+			Style:    component.ButtonStyleDanger,
+		}),
+		component.BuildButton(component.Button{
+			Label:    ctx.GetMessage(i18n.Cancel),
+			CustomId: "closeall_cancel",
+			Style:    component.ButtonStyleSecondary,
+		}),
+	)
+
+	e := utils.BuildEmbed(ctx, customisation.Orange, i18n.TitleCloseAll, i18n.MessageCloseAllPreview, nil, len(tickets))
+	if _, err := ctx.ReplyWith(command.NewEphemeralEmbedMessageResponseWithComponents(e, utils.Slice(buttons))); err != nil {
+		ctx.HandleError(err)
+	}
+}
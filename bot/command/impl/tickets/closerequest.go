@@ -20,6 +20,9 @@ import (
 	"time"
 )
 
+// maxCloseRequestDelayHours is 30 days
+const maxCloseRequestDelayHours = 24 * 30
+
 type CloseRequestCommand struct {
 }
 
@@ -32,10 +35,13 @@ func (c CloseRequestCommand) Properties() registry.Properties {
 		Category:        command.Tickets,
 		InteractionOnly: true,
 		Arguments: command.Arguments(
-			command.NewOptionalArgument("close_delay", "Hours to close the ticket in if the user does not respond", interaction.OptionTypeInteger, "infallible"),
-			command.NewOptionalAutocompleteableArgument("reason", "The reason the ticket was closed", interaction.OptionTypeString, "infallible", c.ReasonAutoCompleteHandler),
+			command.NewOptionalArgument("close_delay", "Hours to close the ticket in if the user does not respond", interaction.OptionTypeInteger, i18n.MessageCloseRequestInvalidDelay).
+				WithRange(1, maxCloseRequestDelayHours),
+			command.NewOptionalAutocompleteableArgument("reason", "The reason the ticket was closed", interaction.OptionTypeString, i18n.MessageCloseReasonTooLong, c.ReasonAutoCompleteHandler).
+				WithMaxLength(255),
 		),
-		Timeout: time.Second * 5,
+		Timeout:  time.Second * 5,
+		Cooldown: command.Cooldown{Uses: 1, Window: time.Second * 30},
 	}
 }
 
@@ -55,11 +61,6 @@ func (CloseRequestCommand) Execute(ctx registry.CommandContext, closeDelay *int,
 		return
 	}
 
-	if reason != nil && len(*reason) > 255 {
-		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageCloseReasonTooLong)
-		return
-	}
-
 	var closeAt *time.Time = nil
 	if closeDelay != nil {
 		tmp := time.Now().Add(time.Hour * time.Duration(*closeDelay))
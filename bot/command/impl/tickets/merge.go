@@ -0,0 +1,93 @@
+package tickets
+
+import (
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/database"
+	"github.com/TicketsBot/worker/bot/command"
+	cmdcontext "github.com/TicketsBot/worker/bot/command/context"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/constants"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/logic"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type MergeCommand struct {
+}
+
+func (MergeCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "merge",
+		Description:     i18n.HelpMerge,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Support,
+		Category:        command.Tickets,
+		Arguments: command.Arguments(
+			command.NewRequiredArgument("ticket_id", "ID of the ticket to merge into this ticket", interaction.OptionTypeInteger, i18n.MessageMergeInvalid),
+		),
+		Timeout: constants.TimeoutCloseTicket,
+	}
+}
+
+func (c MergeCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (MergeCommand) Execute(ctx registry.CommandContext, ticketId int) {
+	target, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// Verify this is a ticket channel
+	if target.UserId == 0 || target.ChannelId == nil {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	if ticketId == target.Id {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageMergeSame)
+		return
+	}
+
+	source, err := dbclient.Client.Tickets.Get(ctx, ticketId, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if source.UserId == 0 || !source.Open || source.ChannelId == nil {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageMergeInvalid, ticketId)
+		return
+	}
+
+	// The user must be able to see both tickets, otherwise they could copy the history of a ticket belonging to another
+	// team into a channel they can see
+	for _, ticket := range []database.Ticket{target, source} {
+		hasPermission, err := logic.HasPermissionForTicket(ctx, ctx.Worker(), ticket, ctx.UserId())
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		if !hasPermission {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageMergeNoPermission)
+			return
+		}
+	}
+
+	if err := logic.MergeTicket(ctx, ctx, target, source); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// Close the source ticket from its own channel, on behalf of the user who merged it
+	closeCtx := cmdcontext.NewAutoCloseContext(ctx, ctx.Worker(), ctx.GuildId(), *source.ChannelId, ctx.UserId(), ctx.PremiumTier())
+	logic.CloseTicket(ctx, closeCtx, utils.Ptr(logic.MergeReason(target.Id)), true)
+
+	ctx.ReplyPermanent(customisation.Green, i18n.TitleMerge, i18n.MessageMergeSuccess, source.Id, target.Id)
+}
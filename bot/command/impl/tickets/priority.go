@@ -0,0 +1,152 @@
+package tickets
+
+import (
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/database"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/logic"
+	"github.com/TicketsBot/worker/bot/redis"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/bot/workerdb"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/rxdn/gdl/rest"
+	"strings"
+	"time"
+)
+
+type PriorityCommand struct {
+}
+
+func (c PriorityCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "priority",
+		Description:     i18n.HelpPriority,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Support,
+		Category:        command.Tickets,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("priority", "New priority for the ticket: low, normal, high or urgent", interaction.OptionTypeString, i18n.MessagePriorityInvalid, c.AutoCompleteHandler),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c PriorityCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (PriorityCommand) Execute(ctx registry.CommandContext, priorityName string) {
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// Check this is a ticket channel
+	if ticket.UserId == 0 || ticket.ChannelId == nil {
+		ctx.Reply(customisation.Red, i18n.TitlePriority, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	priority, ok := workerdb.ParsePriority(priorityName)
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.TitlePriority, i18n.MessagePriorityInvalid)
+		return
+	}
+
+	// The channel name includes the priority, so changing it counts as a rename
+	allowed, err := redis.TakeRenameRatelimit(ctx, ctx.ChannelId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !allowed {
+		ctx.Reply(customisation.Red, i18n.TitlePriority, i18n.MessageRenameRatelimited)
+		return
+	}
+
+	if err := dbclient.WorkerDb.TicketPriority.Set(ctx, ctx.GuildId(), ticket.Id, priority); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	var panel *database.Panel
+	if ticket.PanelId != nil {
+		tmp, err := dbclient.Client.Panel.GetById(ctx, *ticket.PanelId)
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		if tmp.GuildId != 0 {
+			panel = &tmp
+		}
+	}
+
+	claimer, err := dbclient.Client.TicketClaims.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	name, err := logic.GenerateChannelName(ctx, ctx, panel, ticket.Id, ticket.UserId, utils.NilIfZero(claimer))
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ch, err := ctx.Worker().GetChannel(*ticket.ChannelId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	data := rest.ModifyChannelData{}
+	if ch.Name != name {
+		data.Name = name
+	}
+
+	// Threads can't be moved between categories
+	if !ticket.IsThread {
+		parentId, err := logic.GetPriorityParentId(ctx, ctx.GuildId(), panel, priority, ch.ParentId.Value)
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		if parentId != nil {
+			data.ParentId = *parentId
+		}
+	}
+
+	if data.Name != "" || data.ParentId != 0 {
+		if _, err := ctx.Worker().ModifyChannel(*ticket.ChannelId, data); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitlePriority, i18n.MessagePrioritySuccess, logic.PriorityEmoji(priority), priority.String())
+}
+
+func (PriorityCommand) AutoCompleteHandler(_ interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+	value = strings.ToLower(value)
+
+	var choices []interaction.ApplicationCommandOptionChoice
+	for _, priority := range workerdb.Priorities {
+		if strings.Contains(priority.String(), value) {
+			choices = append(choices, interaction.ApplicationCommandOptionChoice{
+				Name:  logic.PriorityEmoji(priority) + " " + priority.String(),
+				Value: priority.String(),
+			})
+		}
+	}
+
+	return choices
+}
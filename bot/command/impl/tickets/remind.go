@@ -0,0 +1,34 @@
+package tickets
+
+import (
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type RemindCommand struct {
+}
+
+func (RemindCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "remind",
+		Description:     i18n.HelpRemind,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Support,
+		Category:        command.Tickets,
+		Children: []registry.Command{
+			RemindSetCommand{},
+			RemindListCommand{},
+		},
+	}
+}
+
+func (c RemindCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (RemindCommand) Execute(_ registry.CommandContext) {
+	// Cannot call parent command
+}
@@ -0,0 +1,88 @@
+package tickets
+
+import (
+	"fmt"
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/rxdn/gdl/objects/interaction/component"
+	"strings"
+	"time"
+)
+
+type RemindListCommand struct {
+}
+
+func (RemindListCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:             "list",
+		Description:      i18n.HelpRemindList,
+		Type:             interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel:  permission.Support,
+		Category:         command.Tickets,
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c RemindListCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (RemindListCommand) Execute(ctx registry.CommandContext) {
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// Verify this is a ticket channel
+	if ticket.UserId == 0 || ticket.ChannelId == nil {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	reminders, err := dbclient.WorkerDb.TicketReminders.GetByTicket(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if len(reminders) == 0 {
+		ctx.Reply(customisation.Green, i18n.TitleReminder, i18n.MessageRemindListEmpty)
+		return
+	}
+
+	var b strings.Builder
+	var buttons []component.Component
+	for i, reminder := range reminders {
+		pingUserId := reminder.CreatedBy
+		if reminder.UserId != nil {
+			pingUserId = *reminder.UserId
+		}
+
+		b.WriteString(fmt.Sprintf("**%d.** <t:%d:R> <@%d>: %s\n", i+1, reminder.RemindAt.Unix(), pingUserId, reminder.Message))
+
+		buttons = append(buttons, component.BuildButton(component.Button{
+			Label:    fmt.Sprintf("%s #%d", ctx.GetMessage(i18n.Cancel), i+1),
+			CustomId: fmt.Sprintf("remind_cancel_%d", reminder.Id),
+			Style:    component.ButtonStyleSecondary,
+		}))
+	}
+
+	// Action rows hold at most 5 buttons
+	var rows []component.Component
+	for i := 0; i < len(buttons); i += 5 {
+		rows = append(rows, component.BuildActionRow(buttons[i:min(i+5, len(buttons))]...))
+	}
+
+	e := utils.BuildEmbed(ctx, customisation.Green, i18n.TitleReminder, i18n.MessageRemindList, nil, b.String())
+	if _, err := ctx.ReplyWith(command.NewEphemeralEmbedMessageResponseWithComponents(e, rows)); err != nil {
+		ctx.HandleError(err)
+	}
+}
@@ -0,0 +1,142 @@
+package tickets
+
+import (
+	"errors"
+	"fmt"
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/database"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/logic"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/bot/workerdb"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/rxdn/gdl/rest/request"
+	"time"
+)
+
+type RemindSetCommand struct {
+}
+
+func (RemindSetCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:             "set",
+		Description:      i18n.HelpRemindSet,
+		Type:             interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel:  permission.Support,
+		Category:         command.Tickets,
+		DefaultEphemeral: true,
+		Arguments: command.Arguments(
+			command.NewRequiredArgument("in", "How long until the reminder, e.g. 2h30m or 1d", interaction.OptionTypeString, i18n.MessageRemindInvalidDuration),
+			command.NewRequiredArgument("message", "What to be reminded about", interaction.OptionTypeString, i18n.MessageInvalidArgument),
+			command.NewOptionalArgument("user", "User to ping instead of yourself", interaction.OptionTypeUser, i18n.MessageInvalidUser),
+		),
+		Timeout: time.Second * 5,
+	}
+}
+
+func (c RemindSetCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (RemindSetCommand) Execute(ctx registry.CommandContext, inRaw, message string, userId *uint64) {
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// Verify this is a ticket channel
+	if ticket.UserId == 0 || ticket.ChannelId == nil {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	duration, err := utils.ParseDuration(inRaw)
+	if err != nil || duration <= 0 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageRemindInvalidDuration)
+		return
+	}
+
+	if duration > logic.MaxReminderDuration {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageRemindTooLong, utils.FormatTime(logic.MaxReminderDuration))
+		return
+	}
+
+	if len(message) > logic.MaxReminderLength {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageRemindMessageTooLong, logic.MaxReminderLength)
+		return
+	}
+
+	count, err := dbclient.WorkerDb.TicketReminders.GetCount(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if count >= logic.MaxTicketReminders {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageRemindLimitReached, logic.MaxTicketReminders)
+		return
+	}
+
+	pingUserId := ctx.UserId()
+	if userId != nil {
+		// Only ping users who can already see the ticket, otherwise they would be pinged into a channel they cannot view
+		canView, err := canViewTicket(ctx, ticket, *userId)
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		if !canView {
+			ctx.Reply(customisation.Red, i18n.Error, i18n.MessageInvalidUser)
+			return
+		}
+
+		pingUserId = *userId
+	}
+
+	reminder := workerdb.TicketReminder{
+		GuildId:   ticket.GuildId,
+		TicketId:  ticket.Id,
+		CreatedBy: ctx.UserId(),
+		UserId:    userId,
+		Message:   message,
+		RemindAt:  time.Now().Add(duration),
+	}
+
+	if _, err := logic.ScheduleReminder(ctx, reminder); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleReminder, i18n.MessageRemindSuccess, pingUserId, fmt.Sprintf("<t:%d:R>", reminder.RemindAt.Unix()))
+}
+
+// canViewTicket returns whether the user opened the ticket, has been added to it, or is staff for it
+func canViewTicket(ctx registry.CommandContext, ticket database.Ticket, userId uint64) (bool, error) {
+	members, err := dbclient.Client.TicketMembers.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return false, err
+	}
+
+	if utils.Contains(members, userId) {
+		return true, nil
+	}
+
+	hasPermission, err := logic.HasPermissionForTicket(ctx, ctx.Worker(), ticket, userId)
+	if err != nil {
+		// The user is no longer in the server
+		var restError request.RestError
+		if errors.As(err, &restError) && restError.StatusCode == 404 {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return hasPermission, nil
+}
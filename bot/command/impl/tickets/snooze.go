@@ -0,0 +1,70 @@
+package tickets
+
+import (
+	"fmt"
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/logic"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"time"
+)
+
+type SnoozeCommand struct {
+}
+
+func (SnoozeCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "snooze",
+		Description:     i18n.HelpSnooze,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Support,
+		Category:        command.Tickets,
+		Arguments: command.Arguments(
+			command.NewRequiredArgument("duration", "How long to snooze the ticket for, e.g. 2h30m or 3d", interaction.OptionTypeString, i18n.MessageSnoozeInvalidDuration),
+			command.NewOptionalArgument("reason", "Why the ticket is being snoozed", interaction.OptionTypeString, i18n.MessageInvalidArgument),
+		),
+		Timeout: time.Second * 10,
+	}
+}
+
+func (c SnoozeCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (SnoozeCommand) Execute(ctx registry.CommandContext, durationRaw string, reason *string) {
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// Verify this is a ticket channel
+	if ticket.UserId == 0 || ticket.ChannelId == nil {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	duration, err := utils.ParseDuration(durationRaw)
+	if err != nil || duration <= 0 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageSnoozeInvalidDuration)
+		return
+	}
+
+	if duration > logic.MaxSnoozeDuration {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageSnoozeTooLong, utils.FormatTime(logic.MaxSnoozeDuration))
+		return
+	}
+
+	until := time.Now().Add(duration)
+	if err := logic.SnoozeTicket(ctx, ctx, ticket, until, reason); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.ReplyPermanent(customisation.Green, i18n.TitleSnooze, i18n.MessageSnoozeSuccess, fmt.Sprintf("<t:%d:f>", until.Unix()))
+}
@@ -82,6 +82,11 @@ func (UnclaimCommand) Execute(ctx *context.SlashCommandContext) {
 		return
 	}
 
+	if err := dbclient.WorkerDb.TicketCoClaimers.DeleteAll(ctx, ctx.GuildId(), ticket.Id); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
 	// get panel
 	var panel *database.Panel
 	if ticket.PanelId != nil {
@@ -109,5 +114,9 @@ func (UnclaimCommand) Execute(ctx *context.SlashCommandContext) {
 		return
 	}
 
+	if err := logic.UpdateWelcomeMessageClaimers(ctx, ctx, ticket); err != nil {
+		ctx.HandleWarning(err)
+	}
+
 	ctx.ReplyPermanent(customisation.Green, i18n.TitleUnclaimed, i18n.MessageUnclaimed)
 }
@@ -0,0 +1,70 @@
+package manager
+
+import (
+	"fmt"
+	"github.com/rxdn/gdl/objects/interaction"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// Discord rejects the whole payload if any name does not match, so invalid translations are skipped instead
+var chatInputNameRegex = regexp.MustCompile(`^[-_\p{L}\p{N}\p{Devanagari}\p{Thai}]{1,32}$`)
+
+const maxDescriptionLength = 100
+
+// removeInvalidLocalizations removes translations that Discord would reject, returning a description of each one
+func removeInvalidLocalizations(commands []CommandData) (skipped []string) {
+	for _, cmd := range commands {
+		isChatInput := cmd.Type == interaction.ApplicationCommandTypeChatInput
+
+		skipped = append(skipped, removeInvalidNames(cmd.NameLocalizations, cmd.Name, isChatInput)...)
+		skipped = append(skipped, removeInvalidDescriptions(cmd.DescriptionLocalizations, cmd.Name)...)
+		skipped = append(skipped, removeInvalidOptionLocalizations(cmd.Options, cmd.Name)...)
+	}
+
+	return
+}
+
+func removeInvalidOptionLocalizations(options []CommandOption, parent string) (skipped []string) {
+	for _, option := range options {
+		name := parent + " " + option.Name
+
+		skipped = append(skipped, removeInvalidNames(option.NameLocalizations, name, true)...)
+		skipped = append(skipped, removeInvalidDescriptions(option.DescriptionLocalizations, name)...)
+		skipped = append(skipped, removeInvalidOptionLocalizations(option.Options, name)...)
+	}
+
+	return
+}
+
+// Names of user and message commands may contain spaces and capitals, so only their length is checked
+func removeInvalidNames(localizations map[string]string, name string, isChatInput bool) (skipped []string) {
+	for locale, translated := range localizations {
+		var valid bool
+		if isChatInput {
+			valid = chatInputNameRegex.MatchString(translated) && strings.ToLower(translated) == translated
+		} else {
+			length := utf8.RuneCountInString(translated)
+			valid = length >= 1 && length <= 32
+		}
+
+		if !valid {
+			delete(localizations, locale)
+			skipped = append(skipped, fmt.Sprintf("%s: name of \"%s\" is invalid: \"%s\"", locale, name, translated))
+		}
+	}
+
+	return
+}
+
+func removeInvalidDescriptions(localizations map[string]string, name string) (skipped []string) {
+	for locale, translated := range localizations {
+		if utf8.RuneCountInString(translated) > maxDescriptionLength {
+			delete(localizations, locale)
+			skipped = append(skipped, fmt.Sprintf("%s: description of \"%s\" is longer than %d characters", locale, name, maxDescriptionLength))
+		}
+	}
+
+	return
+}
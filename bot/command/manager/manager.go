@@ -9,19 +9,30 @@ import (
 	"github.com/TicketsBot/worker/bot/command/impl/tags"
 	"github.com/TicketsBot/worker/bot/command/impl/tickets"
 	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/middleware"
 	"github.com/TicketsBot/worker/i18n"
 	"github.com/rxdn/gdl/objects/interaction"
 	"github.com/rxdn/gdl/rest"
 )
 
 type CommandManager struct {
-	registry registry.Registry
+	registry   registry.Registry
+	middleware middleware.Chain
 }
 
 func (cm *CommandManager) GetCommands() map[string]registry.Command {
 	return cm.registry
 }
 
+// Use adds middleware to the end of the chain that every command is run through before it is executed
+func (cm *CommandManager) Use(m ...middleware.Middleware) {
+	cm.middleware = append(cm.middleware, m...)
+}
+
+func (cm *CommandManager) Middleware() middleware.Chain {
+	return cm.middleware
+}
+
 func (cm *CommandManager) RegisterCommands() {
 	cm.registry = make(map[string]registry.Command)
 
@@ -38,8 +49,10 @@ func (cm *CommandManager) RegisterCommands() {
 	cm.registry["addsupport"] = settings.AddSupportCommand{}
 	cm.registry["autoclose"] = settings.AutoCloseCommand{}
 	cm.registry["blacklist"] = settings.BlacklistCommand{}
+	cm.registry["cooldown"] = settings.CooldownCommand{Registry: cm.registry}
 	cm.registry["language"] = settings.LanguageCommand{}
 	cm.registry["panel"] = settings.PanelCommand{}
+	cm.registry["permissions"] = settings.PermissionsCommand{Registry: cm.registry}
 	cm.registry["premium"] = settings.PremiumCommand{}
 	cm.registry["removeadmin"] = settings.RemoveAdminCommand{}
 	cm.registry["removesupport"] = settings.RemoveSupportCommand{}
@@ -55,14 +68,19 @@ func (cm *CommandManager) RegisterCommands() {
 	cm.registry["add"] = tickets.AddCommand{}
 	cm.registry["claim"] = tickets.ClaimCommand{}
 	cm.registry["close"] = tickets.CloseCommand{}
+	cm.registry["closeall"] = tickets.CloseAllCommand{}
 	cm.registry["closerequest"] = tickets.CloseRequestCommand{}
+	cm.registry["merge"] = tickets.MergeCommand{}
 	cm.registry["notes"] = tickets.NotesCommand{}
 	cm.registry["on-call"] = tickets.OnCallCommand{}
 	cm.registry["open"] = tickets.OpenCommand{}
 	cm.registry["Start Ticket"] = tickets.StartTicketCommand{}
+	cm.registry["priority"] = tickets.PriorityCommand{}
+	cm.registry["remind"] = tickets.RemindCommand{}
 	cm.registry["remove"] = tickets.RemoveCommand{}
 	cm.registry["rename"] = tickets.RenameCommand{}
 	cm.registry["reopen"] = tickets.ReopenCommand{}
+	cm.registry["snooze"] = tickets.SnoozeCommand{}
 	cm.registry["switchpanel"] = tickets.SwitchPanelCommand{}
 	cm.registry["transfer"] = tickets.TransferCommand{}
 	cm.registry["unclaim"] = tickets.UnclaimCommand{}
@@ -76,7 +94,19 @@ func (cm *CommandManager) RunSetupFuncs() {
 	}
 }
 
+// BuildCreatePayload builds the payload using gdl's types, which cannot hold localizations or the bounds of an argument,
+// so these are left out.
+//
+// Deprecated: use BuildCreatePayloadV2, which includes them.
 func (cm *CommandManager) BuildCreatePayload(isWhitelabel bool, adminCommandGuildId *uint64) (data []rest.CreateCommandData, adminCommands []rest.CreateCommandData) {
+	fullData, fullAdminCommands, _ := cm.BuildCreatePayloadV2(isWhitelabel, adminCommandGuildId)
+	return ToCreateCommandData(fullData), ToCreateCommandData(fullAdminCommands)
+}
+
+// BuildCreatePayloadV2 builds the payload to register the commands with, to be sent with ModifyGlobalCommands or
+// ModifyGuildCommands. Translations that Discord would reject are left out, and a description of each is returned in
+// skipped.
+func (cm *CommandManager) BuildCreatePayloadV2(isWhitelabel bool, adminCommandGuildId *uint64) (data []CommandData, adminCommands []CommandData, skipped []string) {
 	for _, cmd := range cm.GetCommands() {
 		properties := cmd.Properties()
 
@@ -84,22 +114,26 @@ func (cm *CommandManager) BuildCreatePayload(isWhitelabel bool, adminCommandGuil
 			continue
 		}
 
-		option := buildOption(cmd)
+		option := buildOption(cmd, properties.Name)
 
 		var description string
+		var descriptionLocalizations map[string]string
 		if properties.Type == interaction.ApplicationCommandTypeChatInput {
 			description = option.Description
+			descriptionLocalizations = option.DescriptionLocalizations
 		}
 
 		if properties.MainBotOnly && isWhitelabel {
 			continue
 		}
 
-		cmdData := rest.CreateCommandData{
-			Name:        option.Name,
-			Description: description,
-			Options:     option.Options,
-			Type:        properties.Type,
+		cmdData := CommandData{
+			Name:                     option.Name,
+			NameLocalizations:        option.NameLocalizations,
+			Description:              description,
+			DescriptionLocalizations: descriptionLocalizations,
+			Options:                  option.Options,
+			Type:                     properties.Type,
 		}
 
 		if properties.HelperOnly || properties.AdminOnly {
@@ -109,22 +143,26 @@ func (cm *CommandManager) BuildCreatePayload(isWhitelabel bool, adminCommandGuil
 		}
 	}
 
-	return data, adminCommands
+	skipped = append(removeInvalidLocalizations(data), removeInvalidLocalizations(adminCommands)...)
+
+	return data, adminCommands, skipped
 }
 
-func buildOption(cmd registry.Command) interaction.ApplicationCommandOption {
+// buildOption builds the option for a command or subcommand, where fullName includes the names of any parent
+// commands, e.g. "claim self"
+func buildOption(cmd registry.Command, fullName string) CommandOption {
 	properties := cmd.Properties()
 
 	// Required args must come before optional args
-	var required []interaction.ApplicationCommandOption
-	var optional []interaction.ApplicationCommandOption
+	var required []CommandOption
+	var optional []CommandOption
 
 	for _, child := range properties.Children {
 		if child.Properties().MessageOnly {
 			continue
 		}
 
-		option := buildOption(child)
+		option := buildOption(child, fullName+" "+child.Properties().Name)
 
 		if option.Required {
 			required = append(required, option)
@@ -134,15 +172,21 @@ func buildOption(cmd registry.Command) interaction.ApplicationCommandOption {
 	}
 
 	for _, argument := range properties.Arguments {
-		option := interaction.ApplicationCommandOption{
-			Type:         argument.Type,
-			Name:         argument.Name,
-			Description:  argument.Description,
-			Default:      false,
-			Required:     argument.Required,
-			Choices:      nil,
-			Autocomplete: argument.AutoCompleteHandler != nil,
-			Options:      nil,
+		option := CommandOption{
+			Type:                     argument.Type,
+			Name:                     argument.Name,
+			NameLocalizations:        i18n.GetDiscordLocalizations(i18n.ArgumentNameId(fullName, argument.Name)),
+			Description:              argument.Description,
+			DescriptionLocalizations: i18n.GetDiscordLocalizations(i18n.ArgumentDescriptionId(fullName, argument.Name)),
+			Required:                 argument.Required,
+			Choices:                  argument.Choices,
+			Autocomplete:             argument.AutoCompleteHandler != nil,
+			Options:                  nil,
+			ChannelTypes:             argument.ChannelTypes,
+			MinValue:                 argument.MinValue,
+			MaxValue:                 argument.MaxValue,
+			MinLength:                argument.MinLength,
+			MaxLength:                argument.MaxLength,
 		}
 
 		if option.Required {
@@ -154,13 +198,20 @@ func buildOption(cmd registry.Command) interaction.ApplicationCommandOption {
 
 	options := append(required, optional...)
 
-	return interaction.ApplicationCommandOption{
-		Type:        interaction.OptionTypeSubCommand,
-		Name:        properties.Name,
-		Description: i18n.GetMessage(i18n.LocaleEnglish, properties.Description),
-		Default:     false,
-		Required:    false,
-		Choices:     nil,
-		Options:     options,
+	// Subcommands that have their own subcommands are groups. The type is ignored for top level commands.
+	optionType := interaction.OptionTypeSubCommand
+	if len(properties.Children) > 0 {
+		optionType = interaction.OptionTypeSubCommandGroup
+	}
+
+	return CommandOption{
+		Type:                     optionType,
+		Name:                     properties.Name,
+		NameLocalizations:        i18n.GetDiscordLocalizations(i18n.CommandNameId(fullName)),
+		Description:              i18n.GetMessage(i18n.LocaleEnglish, properties.Description),
+		DescriptionLocalizations: i18n.GetDiscordLocalizations(properties.Description),
+		Required:                 false,
+		Choices:                  nil,
+		Options:                  options,
 	}
 }
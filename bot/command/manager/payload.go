@@ -0,0 +1,132 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"github.com/rxdn/gdl/objects/channel"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/rxdn/gdl/rest"
+	"github.com/rxdn/gdl/rest/ratelimit"
+	"github.com/rxdn/gdl/rest/request"
+)
+
+// CommandData is used in place of gdl's rest.CreateCommandData, which has no fields for localizations, and whose
+// options have no fields for the bounds of an argument
+type CommandData struct {
+	Id                       uint64                             `json:"id,omitempty"`
+	Name                     string                             `json:"name"`
+	NameLocalizations        map[string]string                  `json:"name_localizations,omitempty"`
+	Description              string                             `json:"description"`
+	DescriptionLocalizations map[string]string                  `json:"description_localizations,omitempty"`
+	Options                  []CommandOption                    `json:"options"`
+	Type                     interaction.ApplicationCommandType `json:"type"`
+}
+
+type CommandOption struct {
+	Type                     interaction.ApplicationCommandOptionType     `json:"type"`
+	Name                     string                                       `json:"name"`
+	NameLocalizations        map[string]string                            `json:"name_localizations,omitempty"`
+	Description              string                                       `json:"description"`
+	DescriptionLocalizations map[string]string                            `json:"description_localizations,omitempty"`
+	Required                 bool                                         `json:"required"`
+	Choices                  []interaction.ApplicationCommandOptionChoice `json:"choices,omitempty"`
+	Autocomplete             bool                                         `json:"autocomplete"`
+	Options                  []CommandOption                              `json:"options,omitempty"`
+	ChannelTypes             []channel.ChannelType                        `json:"channel_types,omitempty"`
+	MinValue                 *float64                                     `json:"min_value,omitempty"`
+	MaxValue                 *float64                                     `json:"max_value,omitempty"`
+	MinLength                *int                                         `json:"min_length,omitempty"`
+	MaxLength                *int                                         `json:"max_length,omitempty"`
+}
+
+// NewCommandOptions converts options fetched from Discord, so that existing commands can be registered again
+func NewCommandOptions(options []interaction.ApplicationCommandOption) []CommandOption {
+	if options == nil {
+		return nil
+	}
+
+	converted := make([]CommandOption, len(options))
+	for i, option := range options {
+		converted[i] = CommandOption{
+			Type:         option.Type,
+			Name:         option.Name,
+			Description:  option.Description,
+			Required:     option.Required,
+			Choices:      option.Choices,
+			Autocomplete: option.Autocomplete,
+			Options:      NewCommandOptions(option.Options),
+			ChannelTypes: option.ChannelTypes,
+		}
+	}
+
+	return converted
+}
+
+// ToCreateCommandData converts commands to gdl's type, for callers that register commands through gdl. Localizations
+// and the bounds of arguments are dropped, as gdl's types have no fields for them.
+func ToCreateCommandData(commands []CommandData) []rest.CreateCommandData {
+	if commands == nil {
+		return nil
+	}
+
+	converted := make([]rest.CreateCommandData, len(commands))
+	for i, cmd := range commands {
+		converted[i] = rest.CreateCommandData{
+			Id:          cmd.Id,
+			Name:        cmd.Name,
+			Description: cmd.Description,
+			Options:     toApplicationCommandOptions(cmd.Options),
+			Type:        cmd.Type,
+		}
+	}
+
+	return converted
+}
+
+func toApplicationCommandOptions(options []CommandOption) []interaction.ApplicationCommandOption {
+	if options == nil {
+		return nil
+	}
+
+	converted := make([]interaction.ApplicationCommandOption, len(options))
+	for i, option := range options {
+		converted[i] = interaction.ApplicationCommandOption{
+			Type:         option.Type,
+			Name:         option.Name,
+			Description:  option.Description,
+			Required:     option.Required,
+			Choices:      option.Choices,
+			Autocomplete: option.Autocomplete,
+			Options:      toApplicationCommandOptions(option.Options),
+			ChannelTypes: option.ChannelTypes,
+		}
+	}
+
+	return converted
+}
+
+func ModifyGlobalCommands(ctx context.Context, token string, rateLimiter *ratelimit.Ratelimiter, applicationId uint64, data []CommandData) (commands []interaction.ApplicationCommand, err error) {
+	endpoint := request.Endpoint{
+		RequestType: request.PUT,
+		ContentType: request.ApplicationJson,
+		Endpoint:    fmt.Sprintf("/applications/%d/commands", applicationId),
+		Route:       ratelimit.NewApplicationRoute(ratelimit.RouteModifyGlobalCommands, applicationId),
+		RateLimiter: rateLimiter,
+	}
+
+	err, _ = endpoint.Request(ctx, token, data, &commands)
+	return
+}
+
+func ModifyGuildCommands(ctx context.Context, token string, rateLimiter *ratelimit.Ratelimiter, applicationId, guildId uint64, data []CommandData) (commands []interaction.ApplicationCommand, err error) {
+	endpoint := request.Endpoint{
+		RequestType: request.PUT,
+		ContentType: request.ApplicationJson,
+		Endpoint:    fmt.Sprintf("/applications/%d/guilds/%d/commands", applicationId, guildId),
+		Route:       ratelimit.NewGuildRoute(ratelimit.RouteModifyGuildCommands, applicationId),
+		RateLimiter: rateLimiter,
+	}
+
+	err, _ = endpoint.Request(ctx, token, data, &commands)
+	return
+}
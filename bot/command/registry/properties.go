@@ -25,6 +25,7 @@ type Properties struct {
 	Arguments        []command.Argument
 	DefaultEphemeral bool
 	Timeout          time.Duration
+	Cooldown         command.Cooldown
 
 	SetupFunc func()
 }
@@ -1,3 +1,31 @@
 package registry
 
 type Registry map[string]Command
+
+// ExecutableCommands returns the commands that can be run, rather than only holding subcommands, keyed by their full
+// name including any subcommands, e.g. "claim self"
+func (r Registry) ExecutableCommands() map[string]Command {
+	commands := make(map[string]Command)
+	for _, cmd := range r {
+		addExecutableCommands(commands, cmd, "")
+	}
+
+	return commands
+}
+
+func addExecutableCommands(commands map[string]Command, cmd Command, prefix string) {
+	properties := cmd.Properties()
+	if properties.MessageOnly {
+		return
+	}
+
+	name := prefix + properties.Name
+	if len(properties.Children) == 0 {
+		commands[name] = cmd
+		return
+	}
+
+	for _, child := range properties.Children {
+		addExecutableCommands(commands, child, name+" ")
+	}
+}
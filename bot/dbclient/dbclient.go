@@ -2,16 +2,23 @@ package dbclient
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/TicketsBot/database"
+	"github.com/TicketsBot/worker/bot/workerdb"
 	"github.com/TicketsBot/worker/config"
-	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"go.uber.org/zap"
 )
 
 var Client *database.Database
 
+// WorkerDb holds the tables that are owned by the worker, rather than the database package
+var WorkerDb *workerdb.Database
+
+// The database package doesn't expose its pool, so keep a reference to it for health checks
+var pool *pgxpool.Pool
+
 func Connect(logger *zap.Logger) {
 	cfg, err := pgxpool.ParseConfig(fmt.Sprintf(
 		"postgres://%s:%s@%s/%s?pool_max_conns=%d",
@@ -28,14 +35,23 @@ func Connect(logger *zap.Logger) {
 	}
 
 	// TODO: Sentry
-	cfg.ConnConfig.LogLevel = pgx.LogLevelWarn
+	cfg.ConnConfig.LogLevel = LogLevel()
 	cfg.ConnConfig.Logger = NewLogAdapter(logger)
 
-	pool, err := pgxpool.ConnectConfig(context.Background(), cfg)
+	pool, err = pgxpool.ConnectConfig(context.Background(), cfg)
 	if err != nil {
 		logger.Fatal("Failed to connect to database", zap.Error(err))
 		return
 	}
 
 	Client = database.NewDatabase(pool)
+	WorkerDb = workerdb.NewDatabase(pool)
+}
+
+func Ping(ctx context.Context) error {
+	if pool == nil {
+		return errors.New("database is not connected")
+	}
+
+	return pool.Ping(ctx)
 }
@@ -2,6 +2,7 @@ package dbclient
 
 import (
 	"context"
+	"github.com/TicketsBot/worker/bot/tracing"
 	"github.com/jackc/pgx/v4"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -19,7 +20,26 @@ func NewLogAdapter(logger *zap.Logger) *LogAdapter {
 	}
 }
 
+// LogLevel returns the level that pgx should log at. When tracing is enabled, pgx must also log successful queries so
+// that spans can be created for them, but these are not written to the logger.
+func LogLevel() pgx.LogLevel {
+	if tracing.Enabled() {
+		return pgx.LogLevelInfo
+	}
+
+	return pgx.LogLevelWarn
+}
+
 func (l *LogAdapter) Log(ctx context.Context, level pgx.LogLevel, msg string, data map[string]interface{}) {
+	switch msg {
+	case "Query", "Exec", "SendBatch", "CopyFrom":
+		tracing.RecordQuery(ctx, "postgresql", msg, data)
+	}
+
+	if level > pgx.LogLevelWarn {
+		return
+	}
+
 	l.logger.Log(pgxLevelToZapLevel(level), msg, toZapFields(data)...)
 }
 
@@ -0,0 +1,132 @@
+package lifecycle
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Manager tracks in-flight work, such as interaction handlers and ticket closes triggered by the message queue, so
+// that the worker can stop accepting new work on shutdown and wait for existing work to finish.
+type Manager struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu       sync.Mutex
+	inFlight int
+	draining bool
+	idle     chan struct{}
+	idleOnce sync.Once
+}
+
+func NewManager() *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Manager{
+		ctx:    ctx,
+		cancel: cancel,
+		idle:   make(chan struct{}),
+	}
+}
+
+// Context is cancelled as soon as shutdown begins. Long-running loops should use it to stop picking up new work.
+func (m *Manager) Context() context.Context {
+	return m.ctx
+}
+
+func (m *Manager) Draining() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.draining
+}
+
+func (m *Manager) InFlight() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.inFlight
+}
+
+// Track registers the start of a new unit of work, returning false if the manager is draining, in which case the work
+// should be rejected. Otherwise, the returned function must be called once the work has finished.
+func (m *Manager) Track() (func(), bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.draining {
+		return nil, false
+	}
+
+	return m.add(), true
+}
+
+// Add registers the start of a unit of work that is accepted even while draining, such as background work belonging
+// to a request that has already been accepted, or a Kafka message that has already been fetched. The returned
+// function must be called once the work has finished.
+func (m *Manager) Add() func() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.add()
+}
+
+// Go runs f in a new goroutine, tracking it with Add until it returns
+func (m *Manager) Go(f func()) {
+	done := m.Add()
+
+	go func() {
+		defer done()
+		f()
+	}()
+}
+
+// Shutdown stops new work from being accepted, cancels Context, and waits up to timeout for in-flight work to finish.
+// It returns whether all work finished before the timeout.
+func (m *Manager) Shutdown(timeout time.Duration) bool {
+	m.mu.Lock()
+	if !m.draining {
+		m.draining = true
+
+		if m.inFlight == 0 {
+			m.closeIdle()
+		}
+	}
+	m.mu.Unlock()
+
+	m.cancel()
+
+	select {
+	case <-m.idle:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// add must be called with mu held
+func (m *Manager) add() func() {
+	m.inFlight++
+
+	var once sync.Once
+	return func() {
+		once.Do(m.done)
+	}
+}
+
+func (m *Manager) done() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.inFlight--
+	if m.draining && m.inFlight == 0 {
+		m.closeIdle()
+	}
+}
+
+// Work started by Go after the manager has become idle may cause it to become idle a second time
+func (m *Manager) closeIdle() {
+	m.idleOnce.Do(func() {
+		close(m.idle)
+	})
+}
@@ -2,6 +2,7 @@ package listeners
 
 import (
 	"context"
+	"errors"
 	"github.com/TicketsBot/common/sentry"
 	"github.com/TicketsBot/worker"
 	"github.com/TicketsBot/worker/bot/dbclient"
@@ -9,28 +10,33 @@ import (
 	"time"
 )
 
-func OnChannelDelete(worker *worker.Context, e events.ChannelDelete) {
+func OnChannelDelete(worker *worker.Context, e events.ChannelDelete) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3) // TODO: Propagate context
 	defer cancel()
 
+	// Each step is independent, so one failing must not skip the others
+	var errs []error
+
 	// If this is a ticket channel, close it
 	if err := sentry.WithSpan1(ctx, "Close ticket by channel", func(span *sentry.Span) error {
 		return dbclient.Client.Tickets.CloseByChannel(ctx, e.Id)
 	}); err != nil {
-		sentry.Error(err)
+		errs = append(errs, err)
 	}
 
 	// if this is a channel category, delete it
 	if err := sentry.WithSpan1(ctx, "Delete category by channel", func(span *sentry.Span) error {
 		return dbclient.Client.ChannelCategory.DeleteByChannel(ctx, e.Id)
 	}); err != nil {
-		sentry.Error(err)
+		errs = append(errs, err)
 	}
 
 	// if this is an archive channel, delete it
 	if err := sentry.WithSpan1(ctx, "Delete archive channel by channel", func(span *sentry.Span) error {
 		return dbclient.Client.ArchiveChannel.DeleteByChannel(ctx, e.Id)
 	}); err != nil {
-		sentry.Error(err)
+		errs = append(errs, err)
 	}
+
+	return errors.Join(errs...)
 }
@@ -19,7 +19,7 @@ import (
 )
 
 // Fires when we receive a guild
-func OnGuildCreate(worker *worker.Context, e events.GuildCreate) {
+func OnGuildCreate(worker *worker.Context, e events.GuildCreate) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*6) // TODO: Propagate context
 	defer cancel()
 
@@ -29,7 +29,7 @@ func OnGuildCreate(worker *worker.Context, e events.GuildCreate) {
 			sentry.Error(err)
 		}
 
-		return
+		return nil
 	}
 
 	if time.Now().Sub(e.JoinedAt) < time.Minute {
@@ -60,6 +60,8 @@ func OnGuildCreate(worker *worker.Context, e events.GuildCreate) {
 			}
 		}
 	}
+
+	return nil
 }
 
 func sendIntroMessage(ctx context.Context, worker *worker.Context, guild guild.Guild, userId uint64) {
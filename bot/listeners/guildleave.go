@@ -15,7 +15,7 @@ import (
  * The inner payload is an unavailable guild object.
  * If the unavailable field is not set, the user was removed from the guild.
  */
-func OnGuildLeave(worker *worker.Context, e events.GuildDelete) {
+func OnGuildLeave(worker *worker.Context, e events.GuildDelete) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3) // TODO: Propagate context
 	defer cancel()
 
@@ -27,17 +27,19 @@ func OnGuildLeave(worker *worker.Context, e events.GuildDelete) {
 
 		if worker.IsWhitelabel {
 			if err := dbclient.Client.WhitelabelGuilds.Delete(ctx, worker.BotId, e.Guild.Id); err != nil {
-				sentry.Error(err)
+				return err
 			}
 		}
 
 		// Exclude from autoclose
 		if err := dbclient.Client.AutoCloseExclude.ExcludeAll(ctx, e.Guild.Id); err != nil {
-			sentry.Error(err)
+			return err
 		}
 
 		if err := dbclient.Client.GuildLeaveTime.Set(ctx, e.Guild.Id); err != nil {
-			sentry.Error(err)
+			return err
 		}
 	}
+
+	return nil
 }
@@ -14,53 +14,53 @@ import (
 
 var (
     
-    ChannelCreateListeners = []func(*worker.Context, events.ChannelCreate){}
-    ChannelDeleteListeners = []func(*worker.Context, events.ChannelDelete){}
-    ChannelPinsUpdateListeners = []func(*worker.Context, events.ChannelPinsUpdate){}
-    ChannelUpdateListeners = []func(*worker.Context, events.ChannelUpdate){}
-    EntitlementCreateListeners = []func(*worker.Context, events.EntitlementCreate){}
-    EntitlementDeleteListeners = []func(*worker.Context, events.EntitlementDelete){}
-    EntitlementUpdateListeners = []func(*worker.Context, events.EntitlementUpdate){}
-    GuildBanAddListeners = []func(*worker.Context, events.GuildBanAdd){}
-    GuildBanRemoveListeners = []func(*worker.Context, events.GuildBanRemove){}
-    GuildCreateListeners = []func(*worker.Context, events.GuildCreate){}
-    GuildDeleteListeners = []func(*worker.Context, events.GuildDelete){}
-    GuildEmojisUpdateListeners = []func(*worker.Context, events.GuildEmojisUpdate){}
-    GuildIntegrationsUpdateListeners = []func(*worker.Context, events.GuildIntegrationsUpdate){}
-    GuildMemberAddListeners = []func(*worker.Context, events.GuildMemberAdd){}
-    GuildMemberRemoveListeners = []func(*worker.Context, events.GuildMemberRemove){}
-    GuildMemberUpdateListeners = []func(*worker.Context, events.GuildMemberUpdate){}
-    GuildMembersChunkListeners = []func(*worker.Context, events.GuildMembersChunk){}
-    GuildRoleCreateListeners = []func(*worker.Context, events.GuildRoleCreate){}
-    GuildRoleDeleteListeners = []func(*worker.Context, events.GuildRoleDelete){}
-    GuildRoleUpdateListeners = []func(*worker.Context, events.GuildRoleUpdate){}
-    GuildUpdateListeners = []func(*worker.Context, events.GuildUpdate){}
-    InvalidSessionListeners = []func(*worker.Context, events.InvalidSession){}
-    InviteCreateListeners = []func(*worker.Context, events.InviteCreate){}
-    InviteDeleteListeners = []func(*worker.Context, events.InviteDelete){}
-    MessageCreateListeners = []func(*worker.Context, events.MessageCreate){}
-    MessageDeleteListeners = []func(*worker.Context, events.MessageDelete){}
-    MessageDeleteBulkListeners = []func(*worker.Context, events.MessageDeleteBulk){}
-    MessageReactionAddListeners = []func(*worker.Context, events.MessageReactionAdd){}
-    MessageReactionRemoveListeners = []func(*worker.Context, events.MessageReactionRemove){}
-    MessageReactionRemoveAllListeners = []func(*worker.Context, events.MessageReactionRemoveAll){}
-    MessageReactionRemoveEmojiListeners = []func(*worker.Context, events.MessageReactionRemoveEmoji){}
-    MessageUpdateListeners = []func(*worker.Context, events.MessageUpdate){}
-    PresenceUpdateListeners = []func(*worker.Context, events.PresenceUpdate){}
-    ReadyListeners = []func(*worker.Context, events.Ready){}
-    ReconnectListeners = []func(*worker.Context, events.Reconnect){}
-    ResumedListeners = []func(*worker.Context, events.Resumed){}
-    ThreadCreateListeners = []func(*worker.Context, events.ThreadCreate){}
-    ThreadDeleteListeners = []func(*worker.Context, events.ThreadDelete){}
-    ThreadListSyncListeners = []func(*worker.Context, events.ThreadListSync){}
-    ThreadMemberUpdateListeners = []func(*worker.Context, events.ThreadMemberUpdate){}
-    ThreadMembersUpdateListeners = []func(*worker.Context, events.ThreadMembersUpdate){}
-    ThreadUpdateListeners = []func(*worker.Context, events.ThreadUpdate){}
-    TypingStartListeners = []func(*worker.Context, events.TypingStart){}
-    UserUpdateListeners = []func(*worker.Context, events.UserUpdate){}
-    VoiceServerUpdateListeners = []func(*worker.Context, events.VoiceServerUpdate){}
-    VoiceStateUpdateListeners = []func(*worker.Context, events.VoiceStateUpdate){}
-    WebhooksUpdateListeners = []func(*worker.Context, events.WebhooksUpdate){}
+    ChannelCreateListeners = []func(*worker.Context, events.ChannelCreate) error{}
+    ChannelDeleteListeners = []func(*worker.Context, events.ChannelDelete) error{}
+    ChannelPinsUpdateListeners = []func(*worker.Context, events.ChannelPinsUpdate) error{}
+    ChannelUpdateListeners = []func(*worker.Context, events.ChannelUpdate) error{}
+    EntitlementCreateListeners = []func(*worker.Context, events.EntitlementCreate) error{}
+    EntitlementDeleteListeners = []func(*worker.Context, events.EntitlementDelete) error{}
+    EntitlementUpdateListeners = []func(*worker.Context, events.EntitlementUpdate) error{}
+    GuildBanAddListeners = []func(*worker.Context, events.GuildBanAdd) error{}
+    GuildBanRemoveListeners = []func(*worker.Context, events.GuildBanRemove) error{}
+    GuildCreateListeners = []func(*worker.Context, events.GuildCreate) error{}
+    GuildDeleteListeners = []func(*worker.Context, events.GuildDelete) error{}
+    GuildEmojisUpdateListeners = []func(*worker.Context, events.GuildEmojisUpdate) error{}
+    GuildIntegrationsUpdateListeners = []func(*worker.Context, events.GuildIntegrationsUpdate) error{}
+    GuildMemberAddListeners = []func(*worker.Context, events.GuildMemberAdd) error{}
+    GuildMemberRemoveListeners = []func(*worker.Context, events.GuildMemberRemove) error{}
+    GuildMemberUpdateListeners = []func(*worker.Context, events.GuildMemberUpdate) error{}
+    GuildMembersChunkListeners = []func(*worker.Context, events.GuildMembersChunk) error{}
+    GuildRoleCreateListeners = []func(*worker.Context, events.GuildRoleCreate) error{}
+    GuildRoleDeleteListeners = []func(*worker.Context, events.GuildRoleDelete) error{}
+    GuildRoleUpdateListeners = []func(*worker.Context, events.GuildRoleUpdate) error{}
+    GuildUpdateListeners = []func(*worker.Context, events.GuildUpdate) error{}
+    InvalidSessionListeners = []func(*worker.Context, events.InvalidSession) error{}
+    InviteCreateListeners = []func(*worker.Context, events.InviteCreate) error{}
+    InviteDeleteListeners = []func(*worker.Context, events.InviteDelete) error{}
+    MessageCreateListeners = []func(*worker.Context, events.MessageCreate) error{}
+    MessageDeleteListeners = []func(*worker.Context, events.MessageDelete) error{}
+    MessageDeleteBulkListeners = []func(*worker.Context, events.MessageDeleteBulk) error{}
+    MessageReactionAddListeners = []func(*worker.Context, events.MessageReactionAdd) error{}
+    MessageReactionRemoveListeners = []func(*worker.Context, events.MessageReactionRemove) error{}
+    MessageReactionRemoveAllListeners = []func(*worker.Context, events.MessageReactionRemoveAll) error{}
+    MessageReactionRemoveEmojiListeners = []func(*worker.Context, events.MessageReactionRemoveEmoji) error{}
+    MessageUpdateListeners = []func(*worker.Context, events.MessageUpdate) error{}
+    PresenceUpdateListeners = []func(*worker.Context, events.PresenceUpdate) error{}
+    ReadyListeners = []func(*worker.Context, events.Ready) error{}
+    ReconnectListeners = []func(*worker.Context, events.Reconnect) error{}
+    ResumedListeners = []func(*worker.Context, events.Resumed) error{}
+    ThreadCreateListeners = []func(*worker.Context, events.ThreadCreate) error{}
+    ThreadDeleteListeners = []func(*worker.Context, events.ThreadDelete) error{}
+    ThreadListSyncListeners = []func(*worker.Context, events.ThreadListSync) error{}
+    ThreadMemberUpdateListeners = []func(*worker.Context, events.ThreadMemberUpdate) error{}
+    ThreadMembersUpdateListeners = []func(*worker.Context, events.ThreadMembersUpdate) error{}
+    ThreadUpdateListeners = []func(*worker.Context, events.ThreadUpdate) error{}
+    TypingStartListeners = []func(*worker.Context, events.TypingStart) error{}
+    UserUpdateListeners = []func(*worker.Context, events.UserUpdate) error{}
+    VoiceServerUpdateListeners = []func(*worker.Context, events.VoiceServerUpdate) error{}
+    VoiceStateUpdateListeners = []func(*worker.Context, events.VoiceStateUpdate) error{}
+    WebhooksUpdateListeners = []func(*worker.Context, events.WebhooksUpdate) error{}
 )
 
 func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload) error {
@@ -77,7 +77,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range ChannelCreateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.CHANNEL_DELETE:
@@ -87,7 +89,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range ChannelDeleteListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.CHANNEL_PINS_UPDATE:
@@ -97,7 +101,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range ChannelPinsUpdateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.CHANNEL_UPDATE:
@@ -107,7 +113,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range ChannelUpdateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.ENTITLEMENT_CREATE:
@@ -117,7 +125,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range EntitlementCreateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.ENTITLEMENT_DELETE:
@@ -127,7 +137,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range EntitlementDeleteListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.ENTITLEMENT_UPDATE:
@@ -137,7 +149,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range EntitlementUpdateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.GUILD_BAN_ADD:
@@ -147,7 +161,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range GuildBanAddListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.GUILD_BAN_REMOVE:
@@ -157,7 +173,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range GuildBanRemoveListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.GUILD_CREATE:
@@ -167,7 +185,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range GuildCreateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.GUILD_DELETE:
@@ -177,7 +197,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range GuildDeleteListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.GUILD_EMOJIS_UPDATE:
@@ -187,7 +209,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range GuildEmojisUpdateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.GUILD_INTEGRATIONS_UPDATE:
@@ -197,7 +221,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range GuildIntegrationsUpdateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.GUILD_MEMBER_ADD:
@@ -207,7 +233,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range GuildMemberAddListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.GUILD_MEMBER_REMOVE:
@@ -217,7 +245,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range GuildMemberRemoveListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.GUILD_MEMBER_UPDATE:
@@ -227,7 +257,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range GuildMemberUpdateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.GUILD_MEMBERS_CHUNK:
@@ -237,7 +269,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range GuildMembersChunkListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.GUILD_ROLE_CREATE:
@@ -247,7 +281,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range GuildRoleCreateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.GUILD_ROLE_DELETE:
@@ -257,7 +293,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range GuildRoleDeleteListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.GUILD_ROLE_UPDATE:
@@ -267,7 +305,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range GuildRoleUpdateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.GUILD_UPDATE:
@@ -277,7 +317,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range GuildUpdateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.INVALID_SESSION:
@@ -287,7 +329,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range InvalidSessionListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.INVITE_CREATE:
@@ -297,7 +341,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range InviteCreateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.INVITE_DELETE:
@@ -307,7 +353,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range InviteDeleteListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.MESSAGE_CREATE:
@@ -317,7 +365,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range MessageCreateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.MESSAGE_DELETE:
@@ -327,7 +377,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range MessageDeleteListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.MESSAGE_DELETE_BULK:
@@ -337,7 +389,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range MessageDeleteBulkListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.MESSAGE_REACTION_ADD:
@@ -347,7 +401,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range MessageReactionAddListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.MESSAGE_REACTION_REMOVE:
@@ -357,7 +413,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range MessageReactionRemoveListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.MESSAGE_REACTION_REMOVE_ALL:
@@ -367,7 +425,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range MessageReactionRemoveAllListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.MESSAGE_REACTION_REMOVE_EMOJI:
@@ -377,7 +437,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range MessageReactionRemoveEmojiListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.MESSAGE_UPDATE:
@@ -387,7 +449,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range MessageUpdateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.PRESENCE_UPDATE:
@@ -397,7 +461,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range PresenceUpdateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.READY:
@@ -407,7 +473,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range ReadyListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.RECONNECT:
@@ -417,7 +485,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range ReconnectListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.RESUMED:
@@ -427,7 +497,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range ResumedListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.THREAD_CREATE:
@@ -437,7 +509,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range ThreadCreateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.THREAD_DELETE:
@@ -447,7 +521,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range ThreadDeleteListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.THREAD_LIST_SYNC:
@@ -457,7 +533,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range ThreadListSyncListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.THREAD_MEMBER_UPDATE:
@@ -467,7 +545,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range ThreadMemberUpdateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.THREAD_MEMBERS_UPDATE:
@@ -477,7 +557,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range ThreadMembersUpdateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.THREAD_UPDATE:
@@ -487,7 +569,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range ThreadUpdateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.TYPING_START:
@@ -497,7 +581,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range TypingStartListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.USER_UPDATE:
@@ -507,7 +593,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range UserUpdateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.VOICE_SERVER_UPDATE:
@@ -517,7 +605,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range VoiceServerUpdateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.VOICE_STATE_UPDATE:
@@ -527,7 +617,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range VoiceStateUpdateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     case events.WEBHOOKS_UPDATE:
@@ -537,7 +629,9 @@ func HandleEvent(c *worker.Context, span *sentry.Span, payload payloads.Payload)
         }
 
         for _, listener := range WebhooksUpdateListeners {
-            listener(c, event)
+            if err := listener(c, event); err != nil {
+                return err
+            }
         }
     
     default:
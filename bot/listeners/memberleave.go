@@ -2,7 +2,7 @@ package listeners
 
 import (
 	"context"
-	"github.com/TicketsBot/common/sentry"
+	"errors"
 	"github.com/TicketsBot/worker"
 	cmdcontext "github.com/TicketsBot/worker/bot/command/context"
 	"github.com/TicketsBot/worker/bot/constants"
@@ -16,34 +16,37 @@ import (
 )
 
 // Remove user permissions when they leave
-func OnMemberLeave(worker *worker.Context, e events.GuildMemberRemove) {
+func OnMemberLeave(worker *worker.Context, e events.GuildMemberRemove) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3) // TODO: Propagate context
 	defer cancel()
 
+	// Each step is independent, so one failing must not skip the others
+	var errs []error
+
 	if err := dbclient.Client.Permissions.RemoveSupport(ctx, e.GuildId, e.User.Id); err != nil {
-		sentry.Error(err)
+		errs = append(errs, err)
 	}
 
 	if err := utils.ToRetriever(worker).Cache().DeleteCachedPermissionLevel(ctx, e.GuildId, e.User.Id); err != nil {
-		sentry.Error(err)
+		errs = append(errs, err)
 	}
 
 	// auto close
 	settings, err := dbclient.Client.AutoClose.Get(ctx, e.GuildId)
 	if err != nil {
-		sentry.Error(err)
+		errs = append(errs, err)
 	} else {
 		// check setting is enabled
 		if settings.Enabled && settings.OnUserLeave != nil && *settings.OnUserLeave {
 			// get open tickets by user
 			tickets, err := dbclient.Client.Tickets.GetOpenByUser(ctx, e.GuildId, e.User.Id)
 			if err != nil {
-				sentry.Error(err)
+				errs = append(errs, err)
 			} else {
 				for _, ticket := range tickets {
 					isExcluded, err := dbclient.Client.AutoCloseExclude.IsExcluded(ctx, e.GuildId, ticket.Id)
 					if err != nil {
-						sentry.Error(err)
+						errs = append(errs, err)
 						continue
 					}
 
@@ -53,14 +56,14 @@ func OnMemberLeave(worker *worker.Context, e events.GuildMemberRemove) {
 
 					// verify ticket exists + prevent potential panic
 					if ticket.ChannelId == nil {
-						return
+						continue
 					}
 
 					// get premium status
 					premiumTier, err := utils.PremiumClient.GetTierByGuildId(ctx, ticket.GuildId, true, worker.Token, worker.RateLimiter)
 					if err != nil {
-						sentry.Error(err)
-						return
+						errs = append(errs, err)
+						continue
 					}
 
 					ctx, cancel := context.WithTimeout(context.Background(), constants.TimeoutCloseTicket)
@@ -73,4 +76,6 @@ func OnMemberLeave(worker *worker.Context, e events.GuildMemberRemove) {
 			}
 		}
 	}
+
+	return errors.Join(errs...)
 }
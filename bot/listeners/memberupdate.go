@@ -10,14 +10,12 @@ import (
 )
 
 // Remove user permissions when they leave
-func OnMemberUpdate(worker *worker.Context, e events.GuildMemberUpdate) {
+func OnMemberUpdate(worker *worker.Context, e events.GuildMemberUpdate) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3) // TODO: Propagate context
 	defer cancel()
 
 	span := sentry.StartSpan(ctx, "OnMemberUpdate")
 	defer span.Finish()
 
-	if err := utils.ToRetriever(worker).Cache().DeleteCachedPermissionLevel(ctx, e.GuildId, e.User.Id); err != nil {
-		sentry.Error(err)
-	}
+	return utils.ToRetriever(worker).Cache().DeleteCachedPermissionLevel(ctx, e.GuildId, e.User.Id)
 }
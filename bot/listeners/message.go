@@ -20,7 +20,7 @@ import (
 )
 
 // proxy messages to web UI + set last message id
-func OnMessage(worker *worker.Context, e events.MessageCreate) {
+func OnMessage(worker *worker.Context, e events.MessageCreate) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*7) // TODO: Propagate context
 	defer cancel()
 
@@ -35,18 +35,18 @@ func OnMessage(worker *worker.Context, e events.MessageCreate) {
 
 	// ignore DMs
 	if e.GuildId == 0 {
-		return
+		return nil
 	}
 
+	// Nothing has been written yet, so the event can safely be retried
 	ticket, isTicket, err := getTicket(span.Context(), e.ChannelId)
 	if err != nil {
-		sentry.ErrorWithContext(err, utils.MessageCreateErrorContext(e))
-		return
+		return err
 	}
 
 	// ensure valid ticket channel
 	if !isTicket || ticket.Id == 0 {
-		return
+		return nil
 	}
 
 	var isStaffCached *bool
@@ -90,7 +90,7 @@ func OnMessage(worker *worker.Context, e events.MessageCreate) {
 	})
 	if err != nil {
 		sentry.ErrorWithContext(err, utils.MessageCreateErrorContext(e))
-		return
+		return nil
 	}
 
 	// proxy msg to web UI
@@ -117,7 +117,7 @@ func OnMessage(worker *worker.Context, e events.MessageCreate) {
 				tmp, err := isStaff(ctx, e, ticket)
 				if err != nil {
 					sentry.ErrorWithContext(err, utils.MessageCreateErrorContext(e))
-					return
+					return nil
 				}
 
 				userIsStaff = tmp
@@ -145,6 +145,8 @@ func OnMessage(worker *worker.Context, e events.MessageCreate) {
 			}
 		}
 	}
+
+	return nil
 }
 
 func updateLastMessage(ctx context.Context, msg events.MessageCreate, ticket database.Ticket, isStaff bool) error {
@@ -0,0 +1,26 @@
+package messagequeue
+
+import (
+	"context"
+	"github.com/TicketsBot/database"
+	cmdcontext "github.com/TicketsBot/worker/bot/command/context"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/lifecycle"
+	"github.com/TicketsBot/worker/bot/logic"
+	"time"
+)
+
+func ListenAccessExpiryTimer(manager *lifecycle.Manager) {
+	listenTimers(manager.Context(), logic.AccessExpiryTimerKey, func(timer logic.AccessExpiryTimer) {
+		manager.Go(func() {
+			// Nothing needs removing from a closed ticket, so the record can be cleaned up without a worker
+			onClosed := func(ctx context.Context) error {
+				return dbclient.WorkerDb.TicketTemporaryAccess.Delete(ctx, timer.GuildId, timer.TicketId, timer.TargetId)
+			}
+
+			runTicketTimer(timer.GuildId, timer.TicketId, time.Second*30, onClosed, func(ctx context.Context, cc *cmdcontext.AutoCloseContext, ticket database.Ticket) error {
+				return logic.HandleAccessExpiryTimer(ctx, cc, ticket, timer.TargetId)
+			})
+		})
+	})
+}
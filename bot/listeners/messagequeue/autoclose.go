@@ -8,24 +8,20 @@ import (
 	cmdcontext "github.com/TicketsBot/worker/bot/command/context"
 	"github.com/TicketsBot/worker/bot/constants"
 	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/lifecycle"
 	"github.com/TicketsBot/worker/bot/logic"
 	"github.com/TicketsBot/worker/bot/metrics/statsd"
-	"github.com/TicketsBot/worker/bot/redis"
 	"github.com/TicketsBot/worker/bot/utils"
 	gdlUtils "github.com/rxdn/gdl/utils"
 )
 
 const AutoCloseReason = "Automatically closed due to inactivity"
 
-func ListenAutoClose() {
-	ch := make(chan autoclose.Ticket)
-	go autoclose.Listen(redis.Client, ch)
-
-	for ticket := range ch {
+func ListenAutoClose(manager *lifecycle.Manager) {
+	listen(manager.Context(), keyAutoClose, func(ticket autoclose.Ticket) {
 		statsd.Client.IncrementKey(statsd.AutoClose)
 
-		ticket := ticket
-		go func() {
+		manager.Go(func() {
 			ctx, cancel := context.WithTimeout(context.Background(), constants.TimeoutCloseTicket)
 			defer cancel()
 
@@ -36,6 +32,17 @@ func ListenAutoClose() {
 				return
 			}
 
+			// Snoozed tickets are exempt from autoclose until the snooze expires
+			snoozed, err := dbclient.WorkerDb.TicketSnoozes.IsSnoozed(ctx, ticket.GuildId, ticket.Id)
+			if err != nil {
+				sentry.Error(err)
+				return
+			}
+
+			if snoozed {
+				return
+			}
+
 			// get worker
 			worker, err := buildContext(ctx, ticket, cache.Client)
 			if err != nil {
@@ -57,6 +64,6 @@ func ListenAutoClose() {
 
 			cc := cmdcontext.NewAutoCloseContext(ctx, worker, ticket.GuildId, *ticket.ChannelId, worker.BotId, premiumTier)
 			logic.CloseTicket(ctx, cc, gdlUtils.StrPtr(AutoCloseReason), true)
-		}()
-	}
+		})
+	})
 }
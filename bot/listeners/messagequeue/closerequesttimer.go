@@ -2,28 +2,23 @@ package messagequeue
 
 import (
 	"context"
-	"github.com/TicketsBot/common/closerequest"
 	"github.com/TicketsBot/common/sentry"
 	"github.com/TicketsBot/database"
 	"github.com/TicketsBot/worker/bot/cache"
 	cmdcontext "github.com/TicketsBot/worker/bot/command/context"
 	"github.com/TicketsBot/worker/bot/constants"
 	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/lifecycle"
 	"github.com/TicketsBot/worker/bot/logic"
 	"github.com/TicketsBot/worker/bot/metrics/statsd"
-	"github.com/TicketsBot/worker/bot/redis"
 	"github.com/TicketsBot/worker/bot/utils"
 )
 
-func ListenCloseRequestTimer() {
-	ch := make(chan database.CloseRequest)
-	go closerequest.Listen(redis.Client, ch)
-
-	for request := range ch {
+func ListenCloseRequestTimer(manager *lifecycle.Manager) {
+	listen(manager.Context(), keyCloseRequestTimer, func(request database.CloseRequest) {
 		statsd.Client.IncrementKey(statsd.AutoClose)
 
-		request := request
-		go func() {
+		manager.Go(func() {
 			ctx, cancel := context.WithTimeout(context.Background(), constants.TimeoutCloseTicket)
 			defer cancel()
 
@@ -55,6 +50,6 @@ func ListenCloseRequestTimer() {
 
 			cc := cmdcontext.NewAutoCloseContext(ctx, worker, ticket.GuildId, *ticket.ChannelId, request.UserId, premiumTier)
 			logic.CloseTicket(ctx, cc, request.Reason, true)
-		}()
-	}
+		})
+	})
 }
@@ -0,0 +1,102 @@
+package messagequeue
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/TicketsBot/common/sentry"
+	"github.com/TicketsBot/worker/bot/logic"
+	"github.com/TicketsBot/worker/bot/redis"
+	"sync"
+	"time"
+)
+
+// These must match the list keys used by the publishers in github.com/TicketsBot/common
+const (
+	keyAutoClose         = "tickets:autoclose"
+	keyCloseRequestTimer = "tickets:closerequest:timer"
+	keyTicketClose       = "tickets:close"
+)
+
+// Short enough that shutdown isn't held up waiting for a pop to return
+const popTimeout = time.Second * 2
+
+// A listener that hasn't completed a pop in this long is considered dead. Allows for a failed pop and the sleep after it.
+const livenessThreshold = popTimeout * 5
+
+var (
+	lastPolled   = make(map[string]time.Time)
+	lastPolledMu sync.RWMutex
+)
+
+type ListenerStatus struct {
+	Key        string    `json:"key"`
+	LastPolled time.Time `json:"last_polled"`
+	Alive      bool      `json:"alive"`
+}
+
+// Status reports whether each listener has recently completed a pop from Redis
+func Status() []ListenerStatus {
+	lastPolledMu.RLock()
+	defer lastPolledMu.RUnlock()
+
+	keys := []string{keyAutoClose, keyCloseRequestTimer, keyTicketClose, logic.SlaTimerKey, logic.SnoozeTimerKey, logic.ReminderTimerKey, logic.AccessExpiryTimerKey}
+
+	statuses := make([]ListenerStatus, len(keys))
+	for i, key := range keys {
+		polledAt := lastPolled[key]
+
+		statuses[i] = ListenerStatus{
+			Key:        key,
+			LastPolled: polledAt,
+			Alive:      time.Since(polledAt) < livenessThreshold,
+		}
+	}
+
+	return statuses
+}
+
+func markPolled(key string) {
+	lastPolledMu.Lock()
+	defer lastPolledMu.Unlock()
+
+	lastPolled[key] = time.Now()
+}
+
+// listen pops messages from the given Redis list until ctx is cancelled, passing each one to handle. The common
+// package's Listen functions block on BLPOP forever, which means that a message could be popped and then dropped
+// after shutdown has begun, so we poll with a timeout instead.
+func listen[T any](ctx context.Context, key string, handle func(T)) {
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		res, err := redis.Client.BLPop(ctx, popTimeout, key).Result()
+		if err != nil {
+			if errors.Is(err, redis.ErrNil) {
+				markPolled(key)
+			} else if ctx.Err() == nil {
+				sentry.Error(err)
+				time.Sleep(popTimeout)
+			}
+
+			continue
+		}
+
+		markPolled(key)
+
+		// res = [list_name, content]
+		if len(res) < 2 {
+			continue
+		}
+
+		var data T
+		if err := json.Unmarshal([]byte(res[1]), &data); err != nil {
+			sentry.Error(err)
+			continue
+		}
+
+		handle(data)
+	}
+}
@@ -0,0 +1,52 @@
+package messagequeue
+
+import (
+	"context"
+	"github.com/TicketsBot/common/sentry"
+	"github.com/TicketsBot/database"
+	cmdcontext "github.com/TicketsBot/worker/bot/command/context"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/lifecycle"
+	"github.com/TicketsBot/worker/bot/logic"
+	"github.com/TicketsBot/worker/bot/workerdb"
+	"time"
+)
+
+func ListenReminderTimer(manager *lifecycle.Manager) {
+	listenTimers(manager.Context(), logic.ReminderTimerKey, func(timer logic.ReminderTimer) {
+		manager.Go(func() {
+			reminder, ok := claimReminder(timer.Id)
+			if !ok {
+				return
+			}
+
+			runTicketTimer(reminder.GuildId, reminder.TicketId, time.Second*10, nil, func(ctx context.Context, cc *cmdcontext.AutoCloseContext, ticket database.Ticket) error {
+				return logic.HandleReminderTimer(ctx, cc, ticket, reminder)
+			})
+		})
+	})
+}
+
+// claimReminder removes the reminder, returning false if it has already been removed, such as by being cancelled after
+// the timer was popped
+func claimReminder(id int) (workerdb.TicketReminder, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	reminder, ok, err := dbclient.WorkerDb.TicketReminders.Get(ctx, id)
+	if err != nil {
+		sentry.Error(err)
+		return workerdb.TicketReminder{}, false
+	}
+
+	if !ok {
+		return workerdb.TicketReminder{}, false
+	}
+
+	if _, err := dbclient.WorkerDb.TicketReminders.Delete(ctx, reminder.Id); err != nil {
+		sentry.Error(err)
+		return workerdb.TicketReminder{}, false
+	}
+
+	return reminder, true
+}
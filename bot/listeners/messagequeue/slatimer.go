@@ -0,0 +1,20 @@
+package messagequeue
+
+import (
+	"context"
+	"github.com/TicketsBot/database"
+	cmdcontext "github.com/TicketsBot/worker/bot/command/context"
+	"github.com/TicketsBot/worker/bot/lifecycle"
+	"github.com/TicketsBot/worker/bot/logic"
+	"time"
+)
+
+func ListenSlaTimer(manager *lifecycle.Manager) {
+	listenTimers(manager.Context(), logic.SlaTimerKey, func(timer logic.SlaTimer) {
+		manager.Go(func() {
+			runTicketTimer(timer.GuildId, timer.TicketId, time.Second*10, nil, func(ctx context.Context, cc *cmdcontext.AutoCloseContext, ticket database.Ticket) error {
+				return logic.HandleSlaTimer(ctx, cc, ticket, timer)
+			})
+		})
+	})
+}
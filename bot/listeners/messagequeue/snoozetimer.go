@@ -0,0 +1,26 @@
+package messagequeue
+
+import (
+	"context"
+	"github.com/TicketsBot/database"
+	cmdcontext "github.com/TicketsBot/worker/bot/command/context"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/lifecycle"
+	"github.com/TicketsBot/worker/bot/logic"
+	"time"
+)
+
+func ListenSnoozeTimer(manager *lifecycle.Manager) {
+	listenTimers(manager.Context(), logic.SnoozeTimerKey, func(timer logic.SnoozeTimer) {
+		manager.Go(func() {
+			// The snooze can be cleaned up without a worker if the ticket has since been closed
+			onClosed := func(ctx context.Context) error {
+				return dbclient.WorkerDb.TicketSnoozes.Delete(ctx, timer.GuildId, timer.TicketId)
+			}
+
+			runTicketTimer(timer.GuildId, timer.TicketId, time.Second*10, onClosed, func(ctx context.Context, cc *cmdcontext.AutoCloseContext, ticket database.Ticket) error {
+				return logic.HandleSnoozeTimer(ctx, cc, ticket)
+			})
+		})
+	})
+}
@@ -10,21 +10,16 @@ import (
 	"github.com/TicketsBot/worker/bot/constants"
 	"github.com/TicketsBot/worker/bot/dbclient"
 	"github.com/TicketsBot/worker/bot/errorcontext"
+	"github.com/TicketsBot/worker/bot/lifecycle"
 	"github.com/TicketsBot/worker/bot/logic"
-	"github.com/TicketsBot/worker/bot/redis"
 	"github.com/TicketsBot/worker/bot/utils"
 	"github.com/TicketsBot/worker/config"
 )
 
 // TODO: Make this good
-func ListenTicketClose() {
-	ch := make(chan closerelay.TicketClose)
-	go closerelay.Listen(redis.Client, ch)
-
-	for payload := range ch {
-		payload := payload
-
-		go func() {
+func ListenTicketClose(manager *lifecycle.Manager) {
+	listen(manager.Context(), keyTicketClose, func(payload closerelay.TicketClose) {
+		manager.Go(func() {
 			ctx, cancel := context.WithTimeout(context.Background(), constants.TimeoutCloseTicket)
 			defer cancel()
 
@@ -104,6 +99,6 @@ func ListenTicketClose() {
 			// ticket.ChannelId cannot be nil
 			cc := cmdcontext.NewDashboardContext(ctx, workerCtx, ticket.GuildId, *ticket.ChannelId, payload.UserId, premiumTier)
 			logic.CloseTicket(ctx, &cc, &payload.Reason, false)
-		}()
-	}
+		})
+	})
 }
@@ -0,0 +1,106 @@
+package messagequeue
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/TicketsBot/common/sentry"
+	"github.com/TicketsBot/database"
+	"github.com/TicketsBot/worker/bot/cache"
+	cmdcontext "github.com/TicketsBot/worker/bot/command/context"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/redis"
+	"github.com/TicketsBot/worker/bot/utils"
+	"time"
+)
+
+// How often the timer sets are checked for due timers. Timers may be delivered up to this long after they are due.
+const timerPollInterval = time.Second
+
+// Maximum number of timers to pop from a set at once
+const timerBatchSize = 100
+
+// listenTimers delivers timers scheduled with redis.ScheduleTimer to handle once they are due, until ctx is cancelled
+func listenTimers[T any](ctx context.Context, key string, handle func(T)) {
+	ticker := time.NewTicker(timerPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		// Keep popping until the set has no due timers left, so that a backlog is cleared without waiting
+		for ctx.Err() == nil {
+			due, err := redis.PopDueTimers(ctx, key, time.Now(), timerBatchSize)
+			if err != nil {
+				if ctx.Err() == nil {
+					sentry.Error(err)
+				}
+
+				break
+			}
+
+			markPolled(key)
+
+			for _, raw := range due {
+				var data T
+				if err := json.Unmarshal([]byte(raw), &data); err != nil {
+					sentry.Error(err)
+					continue
+				}
+
+				handle(data)
+			}
+
+			if len(due) < timerBatchSize {
+				break
+			}
+		}
+	}
+}
+
+// ticketTimerFunc handles a timer for a ticket that is still open, using cc to act in the ticket's channel
+type ticketTimerFunc func(ctx context.Context, cc *cmdcontext.AutoCloseContext, ticket database.Ticket) error
+
+// runTicketTimer loads the ticket that a timer belongs to and, if it is still open, runs fn with a context for the
+// ticket's channel. If the ticket has since been closed, onClosed is run instead, if it is not nil, so that the timer
+// can be cleaned up without building a worker context. Errors are reported to sentry.
+func runTicketTimer(guildId uint64, ticketId int, timeout time.Duration, onClosed func(ctx context.Context) error, fn ticketTimerFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	ticket, err := dbclient.Client.Tickets.Get(ctx, ticketId, guildId)
+	if err != nil {
+		sentry.Error(err)
+		return
+	}
+
+	if !ticket.Open || ticket.ChannelId == nil {
+		if onClosed != nil {
+			if err := onClosed(ctx); err != nil {
+				sentry.Error(err)
+			}
+		}
+
+		return
+	}
+
+	worker, err := buildContext(ctx, ticket, cache.Client)
+	if err != nil {
+		sentry.Error(err)
+		return
+	}
+
+	premiumTier, err := utils.PremiumClient.GetTierByGuildId(ctx, ticket.GuildId, true, worker.Token, worker.RateLimiter)
+	if err != nil {
+		sentry.Error(err)
+		return
+	}
+
+	cc := cmdcontext.NewAutoCloseContext(ctx, worker, ticket.GuildId, *ticket.ChannelId, worker.BotId, premiumTier)
+	if err := fn(ctx, cc, ticket); err != nil {
+		sentry.ErrorWithContext(err, cc.ToErrorContext())
+	}
+}
@@ -2,21 +2,17 @@ package listeners
 
 import (
 	"context"
-	"github.com/TicketsBot/common/sentry"
 	"github.com/TicketsBot/worker"
 	"github.com/TicketsBot/worker/bot/dbclient"
-	"github.com/TicketsBot/worker/bot/errorcontext"
 	"github.com/rxdn/gdl/gateway/payloads/events"
 	"golang.org/x/sync/errgroup"
 	"time"
 )
 
-func OnRoleDelete(worker *worker.Context, e events.GuildRoleDelete) {
+func OnRoleDelete(worker *worker.Context, e events.GuildRoleDelete) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3) // TODO: Propagate context
 	defer cancel()
 
-	errorCtx := errorcontext.WorkerErrorContext{Guild: e.GuildId}
-
 	group, _ := errgroup.WithContext(context.Background())
 
 	group.Go(func() error {
@@ -31,7 +27,5 @@ func OnRoleDelete(worker *worker.Context, e events.GuildRoleDelete) {
 		return dbclient.Client.PanelRoleMentions.DeleteAllRole(ctx, e.RoleId)
 	})
 
-	if err := group.Wait(); err != nil {
-		sentry.ErrorWithContext(err, errorCtx)
-	}
+	return group.Wait()
 }
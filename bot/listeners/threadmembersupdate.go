@@ -13,24 +13,22 @@ import (
 	"time"
 )
 
-func OnThreadMembersUpdate(worker *worker.Context, e events.ThreadMembersUpdate) {
+func OnThreadMembersUpdate(worker *worker.Context, e events.ThreadMembersUpdate) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*6) // TODO: Propagate context
 	defer cancel()
 
 	settings, err := dbclient.Client.Settings.Get(ctx, e.GuildId)
 	if err != nil {
-		sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-		return
+		return err
 	}
 
 	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, e.ThreadId, e.GuildId)
 	if err != nil {
-		sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-		return
+		return err
 	}
 
 	if ticket.Id == 0 || ticket.GuildId != e.GuildId {
-		return
+		return nil
 	}
 
 	if ticket.JoinMessageId != nil {
@@ -38,8 +36,7 @@ func OnThreadMembersUpdate(worker *worker.Context, e events.ThreadMembersUpdate)
 		if ticket.PanelId != nil {
 			tmp, err := dbclient.Client.Panel.GetById(ctx, *ticket.PanelId)
 			if err != nil {
-				sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-				return
+				return err
 			}
 
 			if tmp.PanelId != 0 && e.GuildId == tmp.GuildId {
@@ -49,14 +46,12 @@ func OnThreadMembersUpdate(worker *worker.Context, e events.ThreadMembersUpdate)
 
 		premiumTier, err := utils.PremiumClient.GetTierByGuildId(ctx, e.GuildId, true, worker.Token, worker.RateLimiter)
 		if err != nil {
-			sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-			return
+			return err
 		}
 
 		threadStaff, err := logic.GetStaffInThread(ctx, worker, ticket, e.ThreadId)
 		if err != nil {
-			sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-			return
+			return err
 		}
 
 		if settings.TicketNotificationChannel != nil {
@@ -66,4 +61,6 @@ func OnThreadMembersUpdate(worker *worker.Context, e events.ThreadMembersUpdate)
 			}
 		}
 	}
+
+	return nil
 }
@@ -15,36 +15,33 @@ import (
 	"time"
 )
 
-func OnThreadUpdate(worker *worker.Context, e events.ThreadUpdate) {
+func OnThreadUpdate(worker *worker.Context, e events.ThreadUpdate) error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*6) // TODO: Propagate context
 	defer cancel()
 
 	if e.ThreadMetadata == nil {
-		return
+		return nil
 	}
 
 	settings, err := dbclient.Client.Settings.Get(ctx, e.GuildId)
 	if err != nil {
-		sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-		return
+		return err
 	}
 
 	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, e.Id, e.GuildId)
 	if err != nil {
-		sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-		return
+		return err
 	}
 
 	if ticket.Id == 0 || ticket.GuildId != e.GuildId {
-		return
+		return nil
 	}
 
 	var panel *database.Panel
 	if ticket.PanelId != nil {
 		tmp, err := dbclient.Client.Panel.GetById(ctx, *ticket.PanelId)
 		if err != nil {
-			sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-			return
+			return err
 		}
 
 		if tmp.PanelId != 0 && e.GuildId == tmp.GuildId {
@@ -54,34 +51,32 @@ func OnThreadUpdate(worker *worker.Context, e events.ThreadUpdate) {
 
 	premiumTier, err := utils.PremiumClient.GetTierByGuildId(ctx, e.GuildId, true, worker.Token, worker.RateLimiter)
 	if err != nil {
-		sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-		return
+		return err
 	}
 
 	// Handle thread being unarchived
 	if !ticket.Open && !e.ThreadMetadata.Archived {
 		if err := dbclient.Client.Tickets.SetOpen(ctx, ticket.GuildId, ticket.Id); err != nil {
-			sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-			return
+			return err
 		}
 
 		if settings.TicketNotificationChannel != nil {
 			staffCount, err := logic.GetStaffInThread(ctx, worker, ticket, e.Id)
 			if err != nil {
 				sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-				return
+				return nil
 			}
 
 			data := logic.BuildThreadReopenMessage(ctx, worker, ticket.GuildId, ticket.UserId, ticket.Id, panel, staffCount, premiumTier)
 			msg, err := worker.CreateMessageComplex(*settings.TicketNotificationChannel, data.IntoCreateMessageData())
 			if err != nil {
 				sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-				return
+				return nil
 			}
 
 			if err := dbclient.Client.Tickets.SetJoinMessageId(ctx, ticket.GuildId, ticket.Id, &msg.Id); err != nil {
 				sentry.ErrorWithContext(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-				return
+				return nil
 			}
 		}
 	} else if ticket.Open && e.ThreadMetadata.Archived { // Handle ticket being archived on its own
@@ -91,4 +86,6 @@ func OnThreadUpdate(worker *worker.Context, e events.ThreadUpdate) {
 		cc := cmdcontext.NewAutoCloseContext(ctx, worker, ticket.GuildId, e.Id, worker.BotId, premiumTier)
 		logic.CloseTicket(ctx, cc, utils.Ptr("Thread was archived"), true) // TODO: Translate
 	}
+
+	return nil
 }
@@ -0,0 +1,148 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"github.com/TicketsBot/database"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/redis"
+	"github.com/TicketsBot/worker/bot/workerdb"
+	"github.com/rxdn/gdl/rest/request"
+	"net/http"
+	"sort"
+)
+
+// AutoAssignTicket claims the ticket on behalf of a member of the panel's support team, chosen using the panel's
+// assignment strategy. Returns the ID of the assigned user, or nil if nobody was assigned.
+func AutoAssignTicket(ctx context.Context, cmd registry.CommandContext, panel *database.Panel, ticket database.Ticket) (*uint64, error) {
+	// Threads can't be claimed
+	if panel == nil || ticket.IsThread {
+		return nil, nil
+	}
+
+	strategy, ok, err := dbclient.WorkerDb.PanelAutoAssign.Get(ctx, panel.PanelId)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	candidates, err := getAssignCandidates(ctx, cmd, panel, ticket.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	if strategy == workerdb.AssignOnCall {
+		onCall, err := dbclient.Client.OnCall.GetUsersOnCall(ctx, ticket.GuildId)
+		if err != nil {
+			return nil, err
+		}
+
+		candidates = intersect(candidates, onCall)
+	}
+
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	switch strategy {
+	case workerdb.AssignRoundRobin, workerdb.AssignOnCall:
+		index, err := redis.NextRoundRobinIndex(ctx, panel.PanelId)
+		if err != nil {
+			return nil, err
+		}
+
+		offset := int(index % int64(len(candidates)))
+		candidates = append(candidates[offset:], candidates[:offset]...)
+	case workerdb.AssignLeastLoaded:
+		counts, err := dbclient.WorkerDb.PanelAutoAssign.GetOpenClaimCounts(ctx, ticket.GuildId, candidates)
+		if err != nil {
+			return nil, err
+		}
+
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return counts[candidates[i]] < counts[candidates[j]]
+		})
+	}
+
+	// Candidates are ordered by preference, so assign the first who is still in the server
+	for _, userId := range candidates {
+		if _, err := cmd.Worker().GetGuildMember(ticket.GuildId, userId); err != nil {
+			var restError request.RestError
+			if errors.As(err, &restError) && restError.StatusCode == http.StatusNotFound {
+				continue
+			}
+
+			return nil, err
+		}
+
+		if err := ClaimTicket(ctx, cmd, ticket, userId); err != nil {
+			return nil, err
+		}
+
+		return &userId, nil
+	}
+
+	return nil, nil
+}
+
+// getAssignCandidates returns the users who can handle tickets from the panel, sorted by ID so that round-robin
+// ordering is stable between tickets
+func getAssignCandidates(ctx context.Context, cmd registry.CommandContext, panel *database.Panel, openerId uint64) ([]uint64, error) {
+	users, roles, err := GetAllowedStaffUsersAndRoles(ctx, cmd.GuildId(), panel)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make(map[uint64]struct{})
+	for _, userId := range users {
+		candidates[userId] = struct{}{}
+	}
+
+	// Members are only found by role if they are cached
+	if len(roles) > 0 {
+		members, err := cmd.Worker().Cache.GetGuildMembers(ctx, cmd.GuildId(), false)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, member := range members {
+			for _, roleId := range roles {
+				if member.HasRole(roleId) {
+					candidates[member.User.Id] = struct{}{}
+					break
+				}
+			}
+		}
+	}
+
+	// Staff shouldn't be assigned to their own tickets
+	delete(candidates, openerId)
+	delete(candidates, cmd.Worker().BotId)
+
+	sorted := make([]uint64, 0, len(candidates))
+	for userId := range candidates {
+		sorted = append(sorted, userId)
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	return sorted, nil
+}
+
+func intersect(a, b []uint64) []uint64 {
+	set := make(map[uint64]struct{}, len(b))
+	for _, id := range b {
+		set[id] = struct{}{}
+	}
+
+	var res []uint64
+	for _, id := range a {
+		if _, ok := set[id]; ok {
+			res = append(res, id)
+		}
+	}
+
+	return res
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/TicketsBot/worker/bot/command/registry"
 	"github.com/TicketsBot/worker/bot/customisation"
 	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/utils"
 	"github.com/TicketsBot/worker/i18n"
 	"github.com/rxdn/gdl/objects/channel"
 	"github.com/rxdn/gdl/permission"
@@ -46,6 +47,11 @@ func ClaimTicket(ctx context.Context, cmd registry.CommandContext, ticket databa
 		return err
 	}
 
+	// If the ticket is transferred to a co-claimer, they become the claimer instead
+	if err := dbclient.WorkerDb.TicketCoClaimers.Remove(ctx, ticket.GuildId, ticket.Id, userId); err != nil {
+		return err
+	}
+
 	newOverwrites, err := GenerateClaimedOverwrites(ctx, cmd.Worker(), ticket, userId)
 	if err != nil {
 		return err
@@ -69,7 +75,7 @@ func ClaimTicket(ctx context.Context, cmd registry.CommandContext, ticket databa
 		}
 	}
 
-	return nil
+	return UpdateWelcomeMessageClaimers(ctx, cmd, ticket)
 }
 
 // GenerateClaimedOverwrites If support reps can still view and type, returns (nil, nil)
@@ -84,6 +90,13 @@ func GenerateClaimedOverwrites(ctx context.Context, worker *worker.Context, tick
 		return nil, nil
 	}
 
+	coClaimers, err := dbclient.WorkerDb.TicketCoClaimers.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	claimers := append([]uint64{claimer}, coClaimers...)
+
 	adminUsers, err := dbclient.Client.Permissions.GetAdmins(ctx, ticket.GuildId)
 	if err != nil {
 		return nil, err
@@ -106,7 +119,7 @@ func GenerateClaimedOverwrites(ctx context.Context, worker *worker.Context, tick
 
 	// Support can't view the ticket, and therefore can't type either
 	if !claimSettings.SupportCanView {
-		return overwritesCantView(claimer, worker.BotId, ticket.UserId, ticket.GuildId, adminUsers, adminRoles, integrationRoleId, additionalPermissions), nil
+		return overwritesCantView(claimers, worker.BotId, ticket.UserId, ticket.GuildId, adminUsers, adminRoles, integrationRoleId, additionalPermissions), nil
 	}
 
 	// Support can view the ticket, but can't type
@@ -151,7 +164,7 @@ func GenerateClaimedOverwrites(ctx context.Context, worker *worker.Context, tick
 			}
 		}
 
-		return overwritesCantType(claimer, worker.BotId, ticket.UserId, ticket.GuildId, supportUsers, supportRoles, adminUsers, adminRoles, integrationRoleId, additionalPermissions), nil
+		return overwritesCantType(claimers, worker.BotId, ticket.UserId, ticket.GuildId, supportUsers, supportRoles, adminUsers, adminRoles, integrationRoleId, additionalPermissions), nil
 	}
 
 	// Unreachable
@@ -160,7 +173,7 @@ func GenerateClaimedOverwrites(ctx context.Context, worker *worker.Context, tick
 
 // We should build new overwrites from scratch
 // TODO: Instead of append(), set indices
-func overwritesCantView(claimer, selfId, openerId, guildId uint64, adminUsers, adminRoles []uint64, integrationRoleId *uint64, additionalPermissions database.TicketPermissions) (overwrites []channel.PermissionOverwrite) {
+func overwritesCantView(claimers []uint64, selfId, openerId, guildId uint64, adminUsers, adminRoles []uint64, integrationRoleId *uint64, additionalPermissions database.TicketPermissions) (overwrites []channel.PermissionOverwrite) {
 	overwrites = append(overwrites, BuildUserOverwrite(openerId, additionalPermissions),
 		channel.PermissionOverwrite{ // @everyone
 			Id:    guildId,
@@ -170,14 +183,14 @@ func overwritesCantView(claimer, selfId, openerId, guildId uint64, adminUsers, a
 		},
 	)
 
-	// Add claimer to ticket, and attempt to add self by user
-	adminUserTargets := make([]uint64, len(adminUsers)+1, len(adminUsers)+2)
+	// Add claimers to ticket, and attempt to add self by user
+	adminUserTargets := make([]uint64, len(adminUsers), len(adminUsers)+len(claimers)+1)
 	adminRoleTargets := make([]uint64, len(adminRoles), len(adminRoles)+1)
 
 	copy(adminUserTargets, adminUsers)
 	copy(adminRoleTargets, adminRoles)
 
-	adminUserTargets[len(adminUserTargets)-1] = claimer
+	adminUserTargets = append(adminUserTargets, claimers...)
 
 	if integrationRoleId == nil {
 		adminUserTargets = append(adminUserTargets, selfId)
@@ -211,7 +224,7 @@ var readOnlyAllowed = []permission.Permission{permission.ViewChannel, permission
 var readOnlyDenied = []permission.Permission{permission.SendMessages, permission.AddReactions}
 
 // support & admins are not mutually exclusive due to support teams
-func overwritesCantType(claimerId, selfId, openerId, guildId uint64, supportUsers, supportRoles, adminUsers, adminRoles []uint64, integrationRoleId *uint64, additionalPermissions database.TicketPermissions) (overwrites []channel.PermissionOverwrite) {
+func overwritesCantType(claimers []uint64, selfId, openerId, guildId uint64, supportUsers, supportRoles, adminUsers, adminRoles []uint64, integrationRoleId *uint64, additionalPermissions database.TicketPermissions) (overwrites []channel.PermissionOverwrite) {
 	overwrites = append(overwrites, BuildUserOverwrite(openerId, additionalPermissions),
 		channel.PermissionOverwrite{ // @everyone
 			Id:    guildId,
@@ -221,14 +234,14 @@ func overwritesCantType(claimerId, selfId, openerId, guildId uint64, supportUser
 		},
 	)
 
-	// Add claimer to ticket, and attempt to add self by user
-	adminUserTargets := make([]uint64, len(adminUsers)+1, len(adminUsers)+2)
+	// Add claimers to ticket, and attempt to add self by user
+	adminUserTargets := make([]uint64, len(adminUsers), len(adminUsers)+len(claimers)+1)
 	adminRoleTargets := make([]uint64, len(adminRoles), len(adminRoles)+1)
 
 	copy(adminUserTargets, adminUsers)
 	copy(adminRoleTargets, adminRoles)
 
-	adminUserTargets[len(adminUserTargets)-1] = claimerId
+	adminUserTargets = append(adminUserTargets, claimers...)
 
 	if integrationRoleId == nil {
 		adminUserTargets = append(adminUserTargets, selfId)
@@ -255,8 +268,8 @@ func overwritesCantType(claimerId, selfId, openerId, guildId uint64, supportUser
 	}
 
 	for _, userId := range supportUsers {
-		// Don't exclude claimer, self or admins
-		if userId == claimerId || userId == selfId {
+		// Don't exclude claimers, self or admins
+		if utils.Contains(claimers, userId) || userId == selfId {
 			continue
 		}
 
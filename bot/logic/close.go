@@ -223,6 +223,10 @@ func CloseTicket(ctx context.Context, cmd registry.CommandContext, reason *strin
 		sentry.ErrorWithContext(err, cmd.ToErrorContext())
 	}
 
+	if err := CancelTicketReminders(ctx, ticket.GuildId, ticket.Id); err != nil {
+		sentry.ErrorWithContext(err, cmd.ToErrorContext())
+	}
+
 	// Delete join thread button
 	if ticket.IsThread && ticket.JoinMessageId != nil && settings.TicketNotificationChannel != nil {
 		_ = cmd.Worker().DeleteMessage(*settings.TicketNotificationChannel, *ticket.JoinMessageId)
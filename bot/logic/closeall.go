@@ -0,0 +1,88 @@
+package logic
+
+import (
+	"context"
+	"github.com/TicketsBot/database"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"sort"
+	"time"
+)
+
+// CloseAllRequest is a pending /closeall, stored until the admin who ran it confirms
+type CloseAllRequest struct {
+	UserId uint64         `json:"user_id"`
+	Filter CloseAllFilter `json:"filter"`
+	Reason *string        `json:"reason,omitempty"`
+}
+
+// CloseAllFilter selects which open tickets /closeall closes. Unset fields match every ticket.
+type CloseAllFilter struct {
+	PanelId       *int           `json:"panel_id,omitempty"`
+	OpenedBefore  *time.Time     `json:"opened_before,omitempty"`
+	OpenedAfter   *time.Time     `json:"opened_after,omitempty"`
+	InactiveFor   *time.Duration `json:"inactive_for,omitempty"`
+	UnclaimedOnly bool           `json:"unclaimed_only"`
+	OpenerId      *uint64        `json:"opener_id,omitempty"`
+}
+
+func (f CloseAllFilter) Matches(ticket database.TicketWithMetadata, now time.Time) bool {
+	if ticket.ChannelId == nil {
+		return false
+	}
+
+	if f.PanelId != nil && (ticket.PanelId == nil || *ticket.PanelId != *f.PanelId) {
+		return false
+	}
+
+	if f.OpenedBefore != nil && !ticket.OpenTime.Before(*f.OpenedBefore) {
+		return false
+	}
+
+	if f.OpenedAfter != nil && !ticket.OpenTime.After(*f.OpenedAfter) {
+		return false
+	}
+
+	if f.InactiveFor != nil {
+		lastActivity := ticket.OpenTime
+		if ticket.LastMessageTime != nil {
+			lastActivity = *ticket.LastMessageTime
+		}
+
+		if now.Sub(lastActivity) < *f.InactiveFor {
+			return false
+		}
+	}
+
+	if f.UnclaimedOnly && ticket.ClaimedBy != nil {
+		return false
+	}
+
+	if f.OpenerId != nil && ticket.Ticket.UserId != *f.OpenerId {
+		return false
+	}
+
+	return true
+}
+
+// FindTicketsToClose returns the open tickets in the guild matching the filter, oldest first
+func FindTicketsToClose(ctx context.Context, guildId uint64, filter CloseAllFilter) ([]database.Ticket, error) {
+	open, err := dbclient.Client.Tickets.GetGuildOpenTicketsWithMetadata(ctx, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	var tickets []database.Ticket
+	for _, ticket := range open {
+		if filter.Matches(ticket, now) {
+			tickets = append(tickets, ticket.Ticket)
+		}
+	}
+
+	sort.Slice(tickets, func(i, j int) bool {
+		return tickets[i].Id < tickets[j].Id
+	})
+
+	return tickets, nil
+}
@@ -0,0 +1,43 @@
+package logic
+
+import (
+	"github.com/TicketsBot/database"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCloseAllFilterMatches(t *testing.T) {
+	now := time.Unix(100_000, 0)
+
+	ticket := database.TicketWithMetadata{
+		Ticket: database.Ticket{
+			UserId:    1,
+			ChannelId: utils.Ptr(uint64(2)),
+			PanelId:   utils.Ptr(3),
+			OpenTime:  now.Add(-time.Hour * 48),
+		},
+		TicketLastMessage: database.TicketLastMessage{
+			LastMessageTime: utils.Ptr(now.Add(-time.Hour * 2)),
+		},
+	}
+
+	require.True(t, CloseAllFilter{}.Matches(ticket, now))
+	require.True(t, CloseAllFilter{PanelId: utils.Ptr(3), OpenerId: utils.Ptr(uint64(1))}.Matches(ticket, now))
+	require.False(t, CloseAllFilter{PanelId: utils.Ptr(4)}.Matches(ticket, now))
+	require.False(t, CloseAllFilter{OpenerId: utils.Ptr(uint64(5))}.Matches(ticket, now))
+
+	require.True(t, CloseAllFilter{OpenedBefore: utils.Ptr(now.Add(-time.Hour * 24))}.Matches(ticket, now))
+	require.False(t, CloseAllFilter{OpenedAfter: utils.Ptr(now.Add(-time.Hour * 24))}.Matches(ticket, now))
+
+	// Inactivity is measured from the last message, not the open time
+	require.True(t, CloseAllFilter{InactiveFor: utils.Ptr(time.Hour)}.Matches(ticket, now))
+	require.False(t, CloseAllFilter{InactiveFor: utils.Ptr(time.Hour * 3)}.Matches(ticket, now))
+
+	ticket.ClaimedBy = utils.Ptr(uint64(6))
+	require.False(t, CloseAllFilter{UnclaimedOnly: true}.Matches(ticket, now))
+
+	ticket.ChannelId = nil
+	require.False(t, CloseAllFilter{}.Matches(ticket, now))
+}
@@ -36,7 +36,7 @@ func TranscriptLinkElement(condition bool) CloseEmbedElement {
 			transcriptEmoji = customisation.EmojiTranscript.BuildEmoji()
 		}
 
-		transcriptLink := fmt.Sprintf("https://dashboard.ticketsbot.net/manage/%d/transcripts/view/%d", ticket.GuildId, ticket.Id)
+		transcriptLink := TranscriptUrl(ticket.GuildId, ticket.Id)
 
 		return utils.Slice(component.BuildButton(component.Button{
 			Label: "View Online Transcript",
@@ -139,15 +139,15 @@ func BuildCloseEmbed(
 
 	var claimedBy string
 	{
-		claimUserId, err := dbclient.Client.TicketClaims.Get(ctx, ticket.GuildId, ticket.Id)
+		claimers, err := GetClaimers(ctx, ticket.GuildId, ticket.Id)
 		if err != nil {
 			sentry.Error(err)
 		}
 
-		if claimUserId == 0 {
+		if len(claimers) == 0 {
 			claimedBy = "Not claimed"
 		} else {
-			claimedBy = fmt.Sprintf("<@%d>", claimUserId)
+			claimedBy = formatClaimers(claimers)
 		}
 	}
 
@@ -179,6 +179,21 @@ func BuildCloseEmbed(
 
 	closeEmbed = closeEmbed.AddField(formatTitle("Reason", customisation.EmojiReason, worker.IsWhitelabel), formattedReason, false)
 
+	// Link the transcripts of tickets that were merged with this one
+	mergedInto, err := dbclient.WorkerDb.TicketMerges.GetMergedInto(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		sentry.Error(err)
+	} else if mergedInto != nil {
+		closeEmbed = closeEmbed.AddField(formatTitle("Merged Into", customisation.EmojiId, worker.IsWhitelabel), formatTranscriptLinks(ticket.GuildId, []int{*mergedInto}), false)
+	}
+
+	mergedFrom, err := dbclient.WorkerDb.TicketMerges.GetMergedFrom(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		sentry.Error(err)
+	} else if len(mergedFrom) > 0 {
+		closeEmbed = closeEmbed.AddField(formatTitle("Merged Tickets", customisation.EmojiId, worker.IsWhitelabel), formatTranscriptLinks(ticket.GuildId, mergedFrom), false)
+	}
+
 	var rows []component.Component
 	for _, row := range components {
 		var rowElements []component.Component
@@ -194,6 +209,29 @@ func BuildCloseEmbed(
 	return closeEmbed, rows
 }
 
+func TranscriptUrl(guildId uint64, ticketId int) string {
+	return fmt.Sprintf("https://dashboard.ticketsbot.net/manage/%d/transcripts/view/%d", guildId, ticketId)
+}
+
+// formatTranscriptLinks formats the tickets as markdown links to their transcripts, fitting within an embed field
+func formatTranscriptLinks(guildId uint64, ticketIds []int) string {
+	var formatted string
+	for _, ticketId := range ticketIds {
+		link := fmt.Sprintf("[#%d](%s)", ticketId, TranscriptUrl(guildId, ticketId))
+		if len(formatted)+len(link)+2 > 1024 {
+			break
+		}
+
+		if formatted != "" {
+			formatted += ", "
+		}
+
+		formatted += link
+	}
+
+	return formatted
+}
+
 func formatTitle(s string, emoji customisation.CustomEmoji, isWhitelabel bool) string {
 	if !isWhitelabel {
 		return fmt.Sprintf("%s %s", emoji, s)
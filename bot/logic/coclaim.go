@@ -0,0 +1,168 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/TicketsBot/database"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/channel/embed"
+	"github.com/rxdn/gdl/rest"
+	"strings"
+)
+
+// GetClaimers returns the claimer of the ticket followed by any co-claimers, or nil if the ticket is unclaimed
+func GetClaimers(ctx context.Context, guildId uint64, ticketId int) ([]uint64, error) {
+	claimer, err := dbclient.Client.TicketClaims.Get(ctx, guildId, ticketId)
+	if err != nil {
+		return nil, err
+	}
+
+	if claimer == 0 {
+		return nil, nil
+	}
+
+	coClaimers, err := dbclient.WorkerDb.TicketCoClaimers.Get(ctx, guildId, ticketId)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]uint64{claimer}, coClaimers...), nil
+}
+
+// AddCoClaimer lets another staff member work on a claimed ticket alongside the claimer
+func AddCoClaimer(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket, userId uint64) error {
+	claimer, err := dbclient.Client.TicketClaims.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return err
+	}
+
+	// There is nobody to co-claim with, so the user simply claims the ticket
+	if claimer == 0 {
+		return ClaimTicket(ctx, cmd, ticket, userId)
+	}
+
+	if err := dbclient.WorkerDb.TicketCoClaimers.Add(ctx, ticket.GuildId, ticket.Id, userId); err != nil {
+		return err
+	}
+
+	return refreshClaimedTicket(ctx, cmd, ticket, claimer)
+}
+
+// RemoveCoClaimer removes a co-claimer from the ticket, leaving the claimer in place
+func RemoveCoClaimer(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket, userId uint64) error {
+	claimer, err := dbclient.Client.TicketClaims.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return err
+	}
+
+	if err := dbclient.WorkerDb.TicketCoClaimers.Remove(ctx, ticket.GuildId, ticket.Id, userId); err != nil {
+		return err
+	}
+
+	return refreshClaimedTicket(ctx, cmd, ticket, claimer)
+}
+
+// refreshClaimedTicket regenerates the channel name, permissions and welcome message after the claimers have changed
+func refreshClaimedTicket(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket, claimer uint64) error {
+	if ticket.ChannelId == nil {
+		return errors.New("channel ID is nil")
+	}
+
+	newOverwrites, err := GenerateClaimedOverwrites(ctx, cmd.Worker(), ticket, claimer)
+	if err != nil {
+		return err
+	}
+
+	// If newOverwrites = nil, support can already view and type in the ticket
+	if newOverwrites != nil {
+		var panel *database.Panel
+		if ticket.PanelId != nil {
+			tmp, err := dbclient.Client.Panel.GetById(ctx, *ticket.PanelId)
+			if err != nil {
+				return err
+			}
+
+			if tmp.GuildId != 0 {
+				panel = &tmp
+			}
+		}
+
+		channelName, err := GenerateChannelName(ctx, cmd, panel, ticket.Id, ticket.UserId, &claimer)
+		if err != nil {
+			return err
+		}
+
+		data := rest.ModifyChannelData{
+			Name:                 channelName,
+			PermissionOverwrites: newOverwrites,
+		}
+
+		if _, err = cmd.Worker().ModifyChannel(*ticket.ChannelId, data); err != nil {
+			return err
+		}
+	}
+
+	return UpdateWelcomeMessageClaimers(ctx, cmd, ticket)
+}
+
+// UpdateWelcomeMessageClaimers updates the claimed by field of the ticket's welcome message
+func UpdateWelcomeMessageClaimers(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket) error {
+	if ticket.ChannelId == nil || ticket.WelcomeMessageId == nil {
+		return nil
+	}
+
+	// Error is likely to be due to the message being deleted, in which case there is nothing to update
+	msg, err := cmd.Worker().GetChannelMessage(*ticket.ChannelId, *ticket.WelcomeMessageId)
+	if err != nil || len(msg.Embeds) == 0 {
+		return nil
+	}
+
+	claimers, err := GetClaimers(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return err
+	}
+
+	embeds := utils.PtrElems(msg.Embeds)
+	setClaimedByField(cmd, embeds[0], claimers)
+
+	data := rest.EditMessageData{
+		Content:    msg.Content,
+		Embeds:     embeds,
+		Flags:      uint(msg.Flags),
+		Components: msg.Components,
+	}
+
+	_, err = cmd.Worker().EditMessage(*ticket.ChannelId, *ticket.WelcomeMessageId, data)
+	return err
+}
+
+// setClaimedByField replaces the claimed by field of the embed, removing it if there are no claimers
+func setClaimedByField(cmd registry.CommandContext, e *embed.Embed, claimers []uint64) {
+	name := cmd.GetMessage(i18n.TitleClaimedBy)
+
+	fields := make([]*embed.EmbedField, 0, len(e.Fields)+1)
+	for _, field := range e.Fields {
+		if field.Name != name {
+			fields = append(fields, field)
+		}
+	}
+
+	e.Fields = fields
+
+	if len(claimers) > 0 {
+		e.AddField(name, formatClaimers(claimers), false)
+	}
+}
+
+func formatClaimers(claimers []uint64) string {
+	mentions := make([]string, len(claimers))
+	for i, userId := range claimers {
+		mentions[i] = fmt.Sprintf("<@%d>", userId)
+	}
+
+	return strings.Join(mentions, ", ")
+}
@@ -0,0 +1,73 @@
+package logic
+
+import (
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/bot/workerdb"
+)
+
+type CommandOverride int
+
+const (
+	CommandOverrideNone CommandOverride = iota
+	CommandOverrideAllow
+	CommandOverrideDeny
+)
+
+// ResolveCommandOverride decides whether the overrides for a command allow or deny the user. An override for the user
+// themselves takes precedence over their roles, and if their roles disagree, allow wins. Roles include @everyone, which
+// has the same ID as the guild.
+func ResolveCommandOverride(
+	overrides []workerdb.CommandPermissionOverride,
+	guildId, userId uint64,
+	roles []uint64,
+) CommandOverride {
+	result := CommandOverrideNone
+	for _, override := range overrides {
+		if !override.IsRole {
+			if override.TargetId != userId {
+				continue
+			}
+
+			if override.Allow {
+				return CommandOverrideAllow
+			}
+
+			return CommandOverrideDeny
+		}
+
+		if override.TargetId != guildId && !utils.Contains(roles, override.TargetId) {
+			continue
+		}
+
+		if override.Allow {
+			result = CommandOverrideAllow
+		} else if result == CommandOverrideNone {
+			result = CommandOverrideDeny
+		}
+	}
+
+	return result
+}
+
+// CanRunCommand applies the guild's overrides for the command on top of the permission level it requires. Admins can
+// always run commands, so that they cannot lock themselves out of /permissions.
+func CanRunCommand(
+	overrides []workerdb.CommandPermissionOverride,
+	guildId, userId uint64,
+	roles []uint64,
+	userLevel, requiredLevel permission.PermissionLevel,
+) bool {
+	if userLevel >= permission.Admin {
+		return true
+	}
+
+	switch ResolveCommandOverride(overrides, guildId, userId, roles) {
+	case CommandOverrideAllow:
+		return true
+	case CommandOverrideDeny:
+		return false
+	default:
+		return userLevel >= requiredLevel
+	}
+}
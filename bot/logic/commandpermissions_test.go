@@ -0,0 +1,58 @@
+package logic
+
+import (
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/workerdb"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestResolveCommandOverride(t *testing.T) {
+	const (
+		guildId   uint64 = 1
+		userId    uint64 = 2
+		trialRole uint64 = 3
+		mutedRole uint64 = 4
+	)
+
+	role := func(id uint64, allow bool) workerdb.CommandPermissionOverride {
+		return workerdb.CommandPermissionOverride{TargetId: id, IsRole: true, Allow: allow}
+	}
+
+	user := func(id uint64, allow bool) workerdb.CommandPermissionOverride {
+		return workerdb.CommandPermissionOverride{TargetId: id, Allow: allow}
+	}
+
+	roles := []uint64{trialRole, mutedRole}
+
+	require.Equal(t, CommandOverrideNone, ResolveCommandOverride(nil, guildId, userId, roles))
+	require.Equal(t, CommandOverrideNone, ResolveCommandOverride([]workerdb.CommandPermissionOverride{role(5, true), user(6, false)}, guildId, userId, roles))
+
+	require.Equal(t, CommandOverrideAllow, ResolveCommandOverride([]workerdb.CommandPermissionOverride{role(trialRole, true)}, guildId, userId, roles))
+	require.Equal(t, CommandOverrideDeny, ResolveCommandOverride([]workerdb.CommandPermissionOverride{role(mutedRole, false)}, guildId, userId, roles))
+
+	// @everyone applies to all members
+	require.Equal(t, CommandOverrideDeny, ResolveCommandOverride([]workerdb.CommandPermissionOverride{role(guildId, false)}, guildId, userId, nil))
+
+	// Allow wins between roles, regardless of order
+	require.Equal(t, CommandOverrideAllow, ResolveCommandOverride([]workerdb.CommandPermissionOverride{role(mutedRole, false), role(trialRole, true)}, guildId, userId, roles))
+	require.Equal(t, CommandOverrideAllow, ResolveCommandOverride([]workerdb.CommandPermissionOverride{role(trialRole, true), role(mutedRole, false)}, guildId, userId, roles))
+
+	// The user's own override wins over their roles
+	require.Equal(t, CommandOverrideDeny, ResolveCommandOverride([]workerdb.CommandPermissionOverride{role(trialRole, true), user(userId, false)}, guildId, userId, roles))
+	require.Equal(t, CommandOverrideAllow, ResolveCommandOverride([]workerdb.CommandPermissionOverride{role(guildId, false), user(userId, true)}, guildId, userId, roles))
+}
+
+func TestCanRunCommand(t *testing.T) {
+	deny := []workerdb.CommandPermissionOverride{{TargetId: 1, IsRole: true, Allow: false}}
+	allow := []workerdb.CommandPermissionOverride{{TargetId: 1, IsRole: true, Allow: true}}
+
+	require.True(t, CanRunCommand(nil, 1, 2, nil, permission.Support, permission.Support))
+	require.False(t, CanRunCommand(nil, 1, 2, nil, permission.Everyone, permission.Support))
+
+	require.False(t, CanRunCommand(deny, 1, 2, nil, permission.Support, permission.Support))
+	require.True(t, CanRunCommand(allow, 1, 2, nil, permission.Everyone, permission.Admin))
+
+	// Admins can't be denied
+	require.True(t, CanRunCommand(deny, 1, 2, nil, permission.Admin, permission.Support))
+}
@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"github.com/TicketsBot/common/sentry"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/redis"
+	"github.com/TicketsBot/worker/bot/workerdb"
+)
+
+// CommandSettings are a guild's permission overrides and cooldowns, keyed by the full name of the command, or the
+// cooldown bucket
+type CommandSettings struct {
+	Overrides map[string][]workerdb.CommandPermissionOverride `json:"overrides"`
+	Cooldowns map[string]workerdb.CommandCooldown             `json:"cooldowns"`
+}
+
+// GetCommandSettings returns the guild's command settings. They are needed for every command, so they are cached, and
+// InvalidateCommandSettings must be called after they are changed.
+func GetCommandSettings(ctx context.Context, guildId uint64) (CommandSettings, error) {
+	var settings CommandSettings
+	if err := redis.GetCommandSettings(ctx, guildId, &settings); err == nil {
+		return settings, nil
+	} else if !errors.Is(err, redis.ErrCommandSettingsNotCached) {
+		// Fall back to the database
+		sentry.Error(err)
+	}
+
+	overrides, err := dbclient.WorkerDb.CommandPermissionOverrides.GetAll(ctx, guildId)
+	if err != nil {
+		return CommandSettings{}, err
+	}
+
+	cooldowns, err := dbclient.WorkerDb.CommandCooldowns.GetAll(ctx, guildId)
+	if err != nil {
+		return CommandSettings{}, err
+	}
+
+	settings = CommandSettings{
+		Overrides: make(map[string][]workerdb.CommandPermissionOverride),
+		Cooldowns: make(map[string]workerdb.CommandCooldown),
+	}
+
+	for _, override := range overrides {
+		settings.Overrides[override.CommandName] = append(settings.Overrides[override.CommandName], override)
+	}
+
+	for _, cooldown := range cooldowns {
+		settings.Cooldowns[cooldown.CommandName] = cooldown
+	}
+
+	if err := redis.SetCommandSettings(ctx, guildId, settings); err != nil {
+		sentry.Error(err)
+	}
+
+	return settings, nil
+}
+
+func InvalidateCommandSettings(ctx context.Context, guildId uint64) error {
+	return redis.DeleteCommandSettings(ctx, guildId)
+}
@@ -32,6 +32,15 @@ var MinimalPermissions = [...]permission.Permission{
 }
 
 func BuildUserOverwrite(userId uint64, additionalPermissions database.TicketPermissions) channel.PermissionOverwrite {
+	return buildMemberOverwrite(userId, channel.PermissionTypeMember, additionalPermissions)
+}
+
+// BuildRoleOverwrite gives members of the role the same permissions in a ticket as a user added with /add
+func BuildRoleOverwrite(roleId uint64, additionalPermissions database.TicketPermissions) channel.PermissionOverwrite {
+	return buildMemberOverwrite(roleId, channel.PermissionTypeRole, additionalPermissions)
+}
+
+func buildMemberOverwrite(id uint64, overwriteType channel.PermissionOverwriteType, additionalPermissions database.TicketPermissions) channel.PermissionOverwrite {
 	allow := MinimalPermissions[:]
 	var deny []permission.Permission
 
@@ -54,8 +63,8 @@ func BuildUserOverwrite(userId uint64, additionalPermissions database.TicketPerm
 	}
 
 	return channel.PermissionOverwrite{
-		Id:    userId,
-		Type:  channel.PermissionTypeMember,
+		Id:    id,
+		Type:  overwriteType,
 		Allow: permission.BuildPermissions(allow...),
 		Deny:  permission.BuildPermissions(deny...),
 	}
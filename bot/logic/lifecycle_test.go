@@ -0,0 +1,197 @@
+package logic_test
+
+import (
+	"context"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/logic"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/TicketsBot/worker/testutil"
+	"github.com/rxdn/gdl/objects/channel"
+	"github.com/rxdn/gdl/permission"
+	"github.com/stretchr/testify/require"
+	"slices"
+	"testing"
+	"time"
+)
+
+// These tests run the ticket lifecycle against a fake Discord API, and real Postgres and Redis servers. They are
+// skipped unless WORKER_TEST_POSTGRES_URL and WORKER_TEST_REDIS_ADDR are set.
+
+func TestTicketLifecycleChannel(t *testing.T) {
+	env := testutil.NewEnv(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	opener := env.AddMember("opener")
+	staff := env.AddMember("staff")
+
+	require.NoError(t, dbclient.Client.Permissions.AddSupport(ctx, env.Guild.Id, staff.User.Id))
+	require.NoError(t, dbclient.Client.ChannelCategory.Set(ctx, env.Guild.Id, env.Category.Id))
+
+	// Open
+	cmd, replies := env.SlashCommand(ctx, opener, env.Channel.Id, "open")
+	ticket, err := logic.OpenTicket(ctx, cmd, nil, "I need help", nil)
+	require.NoError(t, err)
+	require.NotNil(t, ticket.ChannelId)
+	require.False(t, ticket.IsThread)
+	require.True(t, replies.Contains(i18n.MessageTicketOpened))
+
+	ch, ok := env.Discord.Channel(*ticket.ChannelId)
+	require.True(t, ok, "ticket channel was not created")
+	require.Equal(t, channel.ChannelTypeGuildText, ch.Type)
+	require.Equal(t, env.Category.Id, ch.ParentId.Value)
+	require.Equal(t, "I need help", ch.Topic)
+	requireOverwrite(t, ch, env.Guild.Id, 0, permission.ViewChannel)
+	requireOverwrite(t, ch, opener.User.Id, permission.ViewChannel, 0)
+	require.NotEmpty(t, env.Discord.Messages(ch.Id), "welcome message was not sent")
+
+	stored, err := dbclient.Client.Tickets.Get(ctx, ticket.Id, env.Guild.Id)
+	require.NoError(t, err)
+	require.True(t, stored.Open)
+	require.Equal(t, ch.Id, *stored.ChannelId)
+	require.NotNil(t, stored.WelcomeMessageId)
+
+	// Claim
+	cmd, _ = env.SlashCommand(ctx, staff, ch.Id, "claim")
+	require.NoError(t, logic.ClaimTicket(ctx, cmd, stored, staff.User.Id))
+
+	claimer, err := dbclient.Client.TicketClaims.Get(ctx, env.Guild.Id, ticket.Id)
+	require.NoError(t, err)
+	require.Equal(t, staff.User.Id, claimer)
+
+	// By default, only the claimer can type once a ticket is claimed
+	ch, _ = env.Discord.Channel(ch.Id)
+	requireOverwrite(t, ch, staff.User.Id, permission.SendMessages, 0)
+	requireOverwrite(t, ch, opener.User.Id, permission.SendMessages, 0)
+
+	// Close
+	env.Discord.AddMessage(ch.Id, opener.User, "Thanks!")
+
+	reason := "Resolved"
+	cmd, _ = env.SlashCommand(ctx, staff, ch.Id, "close")
+	logic.CloseTicket(ctx, cmd, &reason, false)
+
+	_, ok = env.Discord.Channel(ch.Id)
+	require.False(t, ok, "ticket channel was not deleted")
+
+	stored, err = dbclient.Client.Tickets.Get(ctx, ticket.Id, env.Guild.Id)
+	require.NoError(t, err)
+	require.False(t, stored.Open)
+	require.True(t, stored.HasTranscript)
+	require.True(t, env.Archive.Has(env.Guild.Id, ticket.Id), "transcript was not archived")
+
+	closeMetadata, ok, err := dbclient.Client.CloseReason.Get(ctx, env.Guild.Id, ticket.Id)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, reason, *closeMetadata.Reason)
+	require.Equal(t, staff.User.Id, *closeMetadata.ClosedBy)
+
+	participated, err := dbclient.Client.Participants.HasParticipated(ctx, env.Guild.Id, ticket.Id, opener.User.Id)
+	require.NoError(t, err)
+	require.True(t, participated)
+
+	dmChannel, ok := env.Discord.DMChannel(opener.User.Id)
+	require.True(t, ok, "opener was not sent the transcript")
+	require.NotEmpty(t, env.Discord.Messages(dmChannel))
+
+	// Reopen: only thread tickets can be reopened
+	cmd, replies = env.SlashCommand(ctx, opener, env.Channel.Id, "reopen")
+	logic.ReopenTicket(ctx, cmd, ticket.Id)
+	require.True(t, replies.Contains(i18n.MessageReopenNotThread))
+
+	require.Empty(t, env.Discord.UnmatchedRequests())
+}
+
+func TestTicketLifecycleThread(t *testing.T) {
+	env := testutil.NewEnv(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	opener := env.AddMember("opener")
+	staff := env.AddMember("staff")
+
+	require.NoError(t, dbclient.Client.Permissions.AddSupport(ctx, env.Guild.Id, staff.User.Id))
+
+	settings, err := dbclient.Client.Settings.Get(ctx, env.Guild.Id)
+	require.NoError(t, err)
+
+	settings.UseThreads = true
+	require.NoError(t, dbclient.Client.Settings.Set(ctx, env.Guild.Id, settings))
+
+	// Open
+	cmd, replies := env.SlashCommand(ctx, opener, env.Channel.Id, "open")
+	ticket, err := logic.OpenTicket(ctx, cmd, nil, "I need help", nil)
+	require.NoError(t, err)
+	require.NotNil(t, ticket.ChannelId)
+	require.True(t, ticket.IsThread)
+	require.True(t, replies.Contains(i18n.MessageTicketOpened))
+
+	thread, ok := env.Discord.Channel(*ticket.ChannelId)
+	require.True(t, ok, "ticket thread was not created")
+	require.Equal(t, channel.ChannelTypeGuildPrivateThread, thread.Type)
+	require.Equal(t, env.Channel.Id, thread.ParentId.Value)
+	require.Contains(t, env.Discord.ThreadMembers(thread.Id), opener.User.Id)
+	require.NotEmpty(t, env.Discord.Messages(thread.Id), "welcome message was not sent")
+
+	// Claim: threads can't be claimed, as permissions can't be set on them
+	cmd, replies = env.SlashCommand(ctx, staff, thread.Id, "claim")
+	require.NoError(t, logic.ClaimTicket(ctx, cmd, ticket, staff.User.Id))
+	require.True(t, replies.Contains(i18n.MessageClaimThread))
+
+	claimer, err := dbclient.Client.TicketClaims.Get(ctx, env.Guild.Id, ticket.Id)
+	require.NoError(t, err)
+	require.Zero(t, claimer)
+
+	// Close
+	env.Discord.AddMessage(thread.Id, opener.User, "Thanks!")
+
+	cmd, replies = env.SlashCommand(ctx, staff, thread.Id, "close")
+	logic.CloseTicket(ctx, cmd, nil, false)
+	require.True(t, replies.Contains(i18n.MessageCloseSuccess))
+
+	thread, ok = env.Discord.Channel(thread.Id)
+	require.True(t, ok, "ticket thread should not be deleted")
+	require.True(t, thread.ThreadMetadata.Archived)
+	require.True(t, thread.ThreadMetadata.Locked)
+
+	stored, err := dbclient.Client.Tickets.Get(ctx, ticket.Id, env.Guild.Id)
+	require.NoError(t, err)
+	require.False(t, stored.Open)
+	require.True(t, env.Archive.Has(env.Guild.Id, ticket.Id), "transcript was not archived")
+
+	// Reopen. The ticket is marked as open in the database when the thread update event is received from the gateway.
+	cmd, replies = env.SlashCommand(ctx, opener, env.Channel.Id, "reopen")
+	logic.ReopenTicket(ctx, cmd, ticket.Id)
+	require.True(t, replies.Contains(i18n.MessageReopenSuccess))
+
+	thread, _ = env.Discord.Channel(thread.Id)
+	require.False(t, thread.ThreadMetadata.Archived)
+	require.False(t, thread.ThreadMetadata.Locked)
+
+	messages := env.Discord.Messages(thread.Id)
+	require.Equal(t, env.Worker.BotId, messages[len(messages)-1].Author.Id, "reopen notice was not sent to the thread")
+
+	require.Empty(t, env.Discord.UnmatchedRequests())
+}
+
+// requireOverwrite checks that the channel has an overwrite for the ID that allows and denies at least the given
+// permissions
+func requireOverwrite(t *testing.T, ch channel.Channel, id uint64, allow, deny permission.Permission) {
+	t.Helper()
+
+	idx := slices.IndexFunc(ch.PermissionOverwrites, func(o channel.PermissionOverwrite) bool {
+		return o.Id == id
+	})
+	require.NotEqual(t, -1, idx, "no permission overwrite for %d", id)
+
+	overwrite := ch.PermissionOverwrites[idx]
+	if allow != 0 {
+		require.True(t, permission.HasPermissionRaw(overwrite.Allow, allow), "overwrite for %d does not allow %d", id, allow)
+	}
+
+	if deny != 0 {
+		require.True(t, permission.HasPermissionRaw(overwrite.Deny, deny), "overwrite for %d does not deny %d", id, deny)
+	}
+}
@@ -0,0 +1,199 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"github.com/TicketsBot/common/collections"
+	"github.com/TicketsBot/database"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/channel/embed"
+	"github.com/rxdn/gdl/objects/channel/message"
+	"github.com/rxdn/gdl/rest"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// Only the most recent messages are copied into the summary, the full history is kept in the transcript
+	mergeHistoryLimit = 100
+	// Maximum length of each message in the summary
+	mergeMessageLength = 300
+	// Maximum number of summary messages to send
+	mergeSummaryMessages = 5
+	// Leave room within the 4096 character description limit
+	mergeSummaryLength = 4000
+)
+
+// MergeTicket copies a summary of the source ticket's history into the target ticket, gives the source ticket's opener
+// and members access to the target ticket, unions the participants and records the merge. The caller is responsible for
+// closing the source ticket afterwards.
+func MergeTicket(ctx context.Context, cmd registry.CommandContext, target, source database.Ticket) error {
+	if target.ChannelId == nil || source.ChannelId == nil {
+		return fmt.Errorf("ticket has no channel")
+	}
+
+	// Copy history
+	msgs, err := cmd.Worker().GetChannelMessages(*source.ChannelId, rest.GetChannelMessagesData{
+		Limit: mergeHistoryLimit,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Messages are returned newest first
+	for i, j := 0, len(msgs)-1; i < j; i, j = i+1, j-1 {
+		msgs[i], msgs[j] = msgs[j], msgs[i]
+	}
+
+	title := cmd.GetMessage(i18n.MessageMergeHistoryTitle, source.Id)
+	for _, description := range buildMergeSummary(msgs) {
+		e := embed.NewEmbed().
+			SetTitle(title).
+			SetColor(cmd.GetColour(customisation.Blue)).
+			SetDescription(description).
+			SetUrl(TranscriptUrl(source.GuildId, source.Id))
+
+		if _, err := cmd.Worker().CreateMessageEmbed(*target.ChannelId, e); err != nil {
+			return err
+		}
+	}
+
+	// Participants
+	participants, err := dbclient.Client.Participants.GetParticipants(ctx, source.GuildId, source.Id)
+	if err != nil {
+		return err
+	}
+
+	if err := dbclient.Client.Participants.SetBulk(ctx, target.GuildId, target.Id, participants); err != nil {
+		return err
+	}
+
+	// Members
+	sourceMembers, err := dbclient.Client.TicketMembers.Get(ctx, source.GuildId, source.Id)
+	if err != nil {
+		return err
+	}
+
+	targetMembers, err := dbclient.Client.TicketMembers.Get(ctx, target.GuildId, target.Id)
+	if err != nil {
+		return err
+	}
+
+	existing := collections.NewSet[uint64]()
+	existing.Add(target.UserId)
+	for _, userId := range targetMembers {
+		existing.Add(userId)
+	}
+
+	var additionalPermissions database.TicketPermissions
+	if !target.IsThread {
+		additionalPermissions, err = dbclient.Client.TicketPermissions.Get(ctx, target.GuildId)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, userId := range append([]uint64{source.UserId}, sourceMembers...) {
+		if existing.Contains(userId) {
+			continue
+		}
+
+		existing.Add(userId)
+
+		if err := dbclient.Client.TicketMembers.Add(ctx, target.GuildId, target.Id, userId); err != nil {
+			return err
+		}
+
+		// Members may have left the server since joining the source ticket, so don't give up on the others
+		if target.IsThread {
+			if err := cmd.Worker().AddThreadMember(*target.ChannelId, userId); err != nil {
+				cmd.HandleWarning(err)
+			}
+		} else {
+			if err := cmd.Worker().EditChannelPermissions(*target.ChannelId, BuildUserOverwrite(userId, additionalPermissions)); err != nil {
+				cmd.HandleWarning(err)
+			}
+		}
+	}
+
+	return dbclient.WorkerDb.TicketMerges.Add(ctx, target.GuildId, source.Id, target.Id)
+}
+
+// MergeReason is the close reason given to tickets that are merged into another ticket
+func MergeReason(targetTicketId int) string {
+	return fmt.Sprintf("Merged into #%d", targetTicketId)
+}
+
+// truncateUtf8 shortens s to at most max bytes, without cutting a multi-byte character in half
+func truncateUtf8(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+
+	for max > 0 && !utf8.RuneStart(s[max]) {
+		max--
+	}
+
+	return s[:max]
+}
+
+// buildMergeSummary formats the messages as quotes, split into embed descriptions. If the messages do not fit, the
+// oldest are dropped.
+func buildMergeSummary(msgs []message.Message) []string {
+	var lines []string
+	for _, msg := range msgs {
+		content := msg.Content
+		if utf8.RuneCountInString(content) > mergeMessageLength {
+			content = string([]rune(content)[:mergeMessageLength]) + "..."
+		}
+
+		for _, attachment := range msg.Attachments {
+			content += fmt.Sprintf("\n%s", attachment.Url)
+		}
+
+		if strings.TrimSpace(content) == "" {
+			continue
+		}
+
+		quoted := "> " + strings.ReplaceAll(content, "\n", "\n> ")
+		lines = append(lines, fmt.Sprintf("**%s** <t:%d:f>\n%s", utils.EscapeMarkdown(msg.Author.Username), msg.Timestamp.Unix(), quoted))
+	}
+
+	// Fill descriptions from the newest message backwards, so that the oldest messages are the ones dropped
+	var descriptions []string
+	var current string
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := lines[i]
+		line = truncateUtf8(line, mergeSummaryLength)
+
+		if len(current)+len(line)+1 > mergeSummaryLength {
+			descriptions = append(descriptions, current)
+			current = ""
+
+			if len(descriptions) == mergeSummaryMessages {
+				break
+			}
+		}
+
+		if current == "" {
+			current = line
+		} else {
+			current = line + "\n" + current
+		}
+	}
+
+	if current != "" && len(descriptions) < mergeSummaryMessages {
+		descriptions = append(descriptions, current)
+	}
+
+	// Send oldest first
+	for i, j := 0, len(descriptions)-1; i < j; i, j = i+1, j-1 {
+		descriptions[i], descriptions[j] = descriptions[j], descriptions[i]
+	}
+
+	return descriptions
+}
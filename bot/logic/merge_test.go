@@ -0,0 +1,63 @@
+package logic
+
+import (
+	"github.com/rxdn/gdl/objects/channel/message"
+	"github.com/rxdn/gdl/objects/user"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestBuildMergeSummary(t *testing.T) {
+	author := user.User{Id: 1, Username: "opener"}
+
+	msgs := []message.Message{
+		{Author: author, Content: "first", Timestamp: time.Unix(1000, 0)},
+		{Author: author, Content: "", Timestamp: time.Unix(1001, 0)}, // Skipped
+		{Author: author, Content: "line one\nline two", Timestamp: time.Unix(1002, 0)},
+	}
+
+	summary := buildMergeSummary(msgs)
+	require.Len(t, summary, 1)
+	require.Equal(t, "**opener** <t:1000:f>\n> first\n**opener** <t:1002:f>\n> line one\n> line two", summary[0])
+}
+
+func TestBuildMergeSummaryDropsOldest(t *testing.T) {
+	author := user.User{Id: 1, Username: "opener"}
+
+	var msgs []message.Message
+	for i := 0; i < mergeHistoryLimit; i++ {
+		msgs = append(msgs, message.Message{Author: author, Content: strings.Repeat("x", mergeMessageLength), Timestamp: time.Unix(int64(i), 0)})
+	}
+
+	// Mark the newest message, so that we can check it was kept
+	msgs[len(msgs)-1].Content = "newest"
+
+	summary := buildMergeSummary(msgs)
+	require.Len(t, summary, mergeSummaryMessages)
+
+	for _, description := range summary {
+		require.LessOrEqual(t, len(description), mergeSummaryLength)
+	}
+
+	require.True(t, strings.HasSuffix(summary[len(summary)-1], "> newest"))
+	require.NotContains(t, summary[0], "<t:0:f>", "oldest message should have been dropped")
+}
+
+func TestBuildMergeSummaryMultiByte(t *testing.T) {
+	author := user.User{Id: 1, Username: "opener"}
+
+	msgs := []message.Message{
+		{Author: author, Content: strings.Repeat("é", mergeMessageLength+1), Timestamp: time.Unix(1000, 0)},
+	}
+
+	summary := buildMergeSummary(msgs)
+	require.Len(t, summary, 1)
+	require.True(t, utf8.ValidString(summary[0]))
+	require.True(t, strings.HasSuffix(summary[0], strings.Repeat("é", mergeMessageLength)+"..."))
+
+	require.Equal(t, "a", truncateUtf8("aé", 2))
+	require.Equal(t, "aé", truncateUtf8("aé", 3))
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/TicketsBot/worker/bot/metrics/statsd"
 	"github.com/TicketsBot/worker/bot/redis"
 	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/bot/workerdb"
 	"github.com/TicketsBot/worker/i18n"
 	"github.com/rxdn/gdl/objects/channel"
 	"github.com/rxdn/gdl/objects/channel/message"
@@ -201,6 +202,27 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 	}
 	span.Finish()
 
+	span = sentry.StartSpan(rootSpan.Context(), "Determine priority")
+	priority, err := DeterminePriority(ctx, panel, formData)
+	if err != nil {
+		cmd.HandleError(err)
+		return database.Ticket{}, err
+	}
+
+	// Tickets with a priority may be placed in their own category
+	if !isThread {
+		priorityCategory, err := GetPriorityCategory(ctx, cmd.GuildId(), priority)
+		if err != nil {
+			cmd.HandleError(err)
+			return database.Ticket{}, err
+		}
+
+		if priorityCategory != nil {
+			category = *priorityCategory
+		}
+	}
+	span.Finish()
+
 	useCategory := category != 0 && !isThread
 	if useCategory {
 		span := sentry.StartSpan(rootSpan.Context(), "Check if category exists")
@@ -265,6 +287,14 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 	}
 	span.Finish()
 
+	// Tickets without a stored priority are treated as normal priority
+	if priority != workerdb.PriorityNormal {
+		if err := dbclient.WorkerDb.TicketPriority.Set(ctx, cmd.GuildId(), ticketId, priority); err != nil {
+			cmd.HandleError(err)
+			return database.Ticket{}, err
+		}
+	}
+
 	unlocked = true
 	if _, err := mu.UnlockContext(ctx); err != nil && !errors.Is(err, redis.ErrLockExpired) {
 		cmd.HandleError(err)
@@ -384,17 +414,6 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 
 	prometheus.TicketsCreated.Inc()
 
-	// Parallelise as much as possible
-	group, _ := errgroup.WithContext(ctx)
-
-	// Let the user know the ticket has been opened
-	group.Go(func() error {
-		span := sentry.StartSpan(rootSpan.Context(), "Reply to interaction")
-		cmd.Reply(customisation.Green, i18n.Ticket, i18n.MessageTicketOpened, ch.Mention())
-		span.Finish()
-		return nil
-	})
-
 	// WelcomeMessageId is modified in the welcome message goroutine
 	ticket := database.Ticket{
 		Id:               ticketId,
@@ -409,6 +428,25 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 		JoinMessageId:    joinMessageId,
 	}
 
+	// The claimers are shown in the welcome message, so the assignee must be chosen first. Failing to assign the
+	// ticket shouldn't prevent it from being opened.
+	span = sentry.StartSpan(rootSpan.Context(), "Auto-assign ticket")
+	if _, err := AutoAssignTicket(ctx, cmd, panel, ticket); err != nil {
+		sentry.ErrorWithContext(err, cmd.ToErrorContext())
+	}
+	span.Finish()
+
+	// Parallelise as much as possible
+	group, _ := errgroup.WithContext(ctx)
+
+	// Let the user know the ticket has been opened
+	group.Go(func() error {
+		span := sentry.StartSpan(rootSpan.Context(), "Reply to interaction")
+		cmd.Reply(customisation.Green, i18n.Ticket, i18n.MessageTicketOpened, ch.Mention())
+		span.Finish()
+		return nil
+	})
+
 	// Welcome message
 	group.Go(func() error {
 		span = sentry.StartSpan(rootSpan.Context(), "Fetch custom integration placeholders")
@@ -443,39 +481,20 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 
 	// Send mentions
 	group.Go(func() error {
-		span := sentry.StartSpan(rootSpan.Context(), "Load guild metadata from database")
-		metadata, err := dbclient.Client.GuildMetadata.Get(ctx, cmd.GuildId())
-		span.Finish()
-		if err != nil {
-			return err
-		}
-
 		// mentions
 		var content string
 
 		// Append on-call role pings
 		if isThread {
-			if panel == nil {
-				if metadata.OnCallRole != nil {
-					content += fmt.Sprintf("<@&%d>", *metadata.OnCallRole)
-				}
-			} else {
-				if panel.WithDefaultTeam && metadata.OnCallRole != nil {
-					content += fmt.Sprintf("<@&%d>", *metadata.OnCallRole)
-				}
+			span := sentry.StartSpan(rootSpan.Context(), "Get on-call roles")
+			onCallRoles, err := GetOnCallRoles(ctx, cmd.GuildId(), panel)
+			span.Finish()
+			if err != nil {
+				return err
+			}
 
-				span := sentry.StartSpan(rootSpan.Context(), "Get teams from database")
-				teams, err := dbclient.Client.PanelTeams.GetTeams(ctx, panel.PanelId)
-				span.Finish()
-				if err != nil {
-					return err
-				} else {
-					for _, team := range teams {
-						if team.OnCallRole != nil {
-							content += fmt.Sprintf("<@&%d>", *team.OnCallRole)
-						}
-					}
-				}
+			for _, roleId := range onCallRoles {
+				content += fmt.Sprintf("<@&%d>", roleId)
 			}
 		}
 
@@ -540,6 +559,13 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 		return nil
 	})
 
+	// Schedule SLA timers
+	if panel != nil {
+		group.Go(func() error {
+			return ScheduleSlaTimers(ctx, ticket)
+		})
+	}
+
 	// Create webhook
 	// TODO: Create webhook on use, rather than on ticket creation.
 	// TODO: Webhooks for threads should be created on the parent channel.
@@ -925,6 +951,11 @@ func GetIntegrationRoleId(rootCtx context.Context, worker *worker.Context, guild
 }
 
 func GenerateChannelName(ctx context.Context, cmd registry.CommandContext, panel *database.Panel, ticketId int, openerId uint64, claimer *uint64) (string, error) {
+	priority, err := dbclient.WorkerDb.TicketPriority.Get(ctx, cmd.GuildId(), ticketId)
+	if err != nil {
+		return "", err
+	}
+
 	// Create ticket name
 	var name string
 
@@ -952,8 +983,12 @@ func GenerateChannelName(ctx context.Context, cmd registry.CommandContext, panel
 		} else {
 			name = fmt.Sprintf("%s-%d", strTicket, ticketId)
 		}
+
+		// Only highlight tickets that have been given a priority
+		if priority != workerdb.PriorityNormal {
+			name = fmt.Sprintf("%s-%s", PriorityEmoji(priority), name)
+		}
 	} else {
-		var err error
 		name, err = doSubstitutions(cmd, *panel.NamingScheme, openerId, []Substitutor{
 			// %id%
 			NewSubstitutor("id", false, false, func(user user.User, member member.Member) string {
@@ -971,6 +1006,28 @@ func GenerateChannelName(ctx context.Context, cmd registry.CommandContext, panel
 					return "claimed"
 				}
 			}),
+			// %claimer_count%
+			NewSubstitutor("claimer_count", false, false, func(user user.User, member member.Member) string {
+				if claimer == nil {
+					return "0"
+				}
+
+				coClaimers, err := dbclient.WorkerDb.TicketCoClaimers.Get(ctx, cmd.GuildId(), ticketId)
+				if err != nil {
+					sentry.ErrorWithContext(err, cmd.ToErrorContext())
+					return "1"
+				}
+
+				return strconv.Itoa(len(coClaimers) + 1)
+			}),
+			// %priority%
+			NewSubstitutor("priority", false, false, func(user user.User, member member.Member) string {
+				return priority.String()
+			}),
+			// %priority_emoji%
+			NewSubstitutor("priority_emoji", false, false, func(user user.User, member member.Member) string {
+				return PriorityEmoji(priority)
+			}),
 			// %username%
 			NewSubstitutor("username", true, false, func(user user.User, member member.Member) string {
 				return user.Username
@@ -999,6 +1056,37 @@ func GenerateChannelName(ctx context.Context, cmd registry.CommandContext, panel
 	return name, nil
 }
 
+// GetOnCallRoles returns the on-call roles of the teams that handle tickets from the panel, or of the default team if
+// panel is nil
+func GetOnCallRoles(ctx context.Context, guildId uint64, panel *database.Panel) ([]uint64, error) {
+	metadata, err := dbclient.Client.GuildMetadata.Get(ctx, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	var roles []uint64
+	if panel == nil || panel.WithDefaultTeam {
+		if metadata.OnCallRole != nil {
+			roles = append(roles, *metadata.OnCallRole)
+		}
+	}
+
+	if panel != nil {
+		teams, err := dbclient.Client.PanelTeams.GetTeams(ctx, panel.PanelId)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, team := range teams {
+			if team.OnCallRole != nil {
+				roles = append(roles, *team.OnCallRole)
+			}
+		}
+	}
+
+	return roles, nil
+}
+
 func countRealChannels(channels []channel.Channel, parentId uint64) int {
 	var count int
 
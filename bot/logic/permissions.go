@@ -71,7 +71,7 @@ func HasPermissionForTicket(ctx context.Context, worker *worker.Context, ticket
 		}
 
 		// We have already checked admin users
-		return false, nil
+		return dbclient.WorkerDb.TicketCoClaimers.IsCoClaimer(ctx, ticket.GuildId, ticket.Id, userId)
 	}
 
 	if ticket.PanelId == nil {
@@ -0,0 +1,113 @@
+package logic
+
+import (
+	"context"
+	"github.com/TicketsBot/database"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/workerdb"
+)
+
+func PriorityEmoji(priority workerdb.Priority) string {
+	switch priority {
+	case workerdb.PriorityLow:
+		return "🟢"
+	case workerdb.PriorityNormal:
+		return "🟡"
+	case workerdb.PriorityHigh:
+		return "🟠"
+	case workerdb.PriorityUrgent:
+		return "🔴"
+	default:
+		return ""
+	}
+}
+
+// DeterminePriority returns the priority that a new ticket should be given. If the panel is configured to take the
+// priority from a form answer, and the answer is a valid priority, it is used. Otherwise, the panel's default priority
+// is used, falling back to PriorityNormal.
+func DeterminePriority(ctx context.Context, panel *database.Panel, formData map[database.FormInput]string) (workerdb.Priority, error) {
+	if panel == nil {
+		return workerdb.PriorityNormal, nil
+	}
+
+	settings, ok, err := dbclient.WorkerDb.PanelPriority.Get(ctx, panel.PanelId)
+	if err != nil {
+		return 0, err
+	}
+
+	if !ok {
+		return workerdb.PriorityNormal, nil
+	}
+
+	if settings.FormInputId != nil {
+		for input, answer := range formData {
+			if input.Id != *settings.FormInputId {
+				continue
+			}
+
+			if priority, ok := workerdb.ParsePriority(answer); ok {
+				return priority, nil
+			}
+
+			break
+		}
+	}
+
+	if settings.DefaultPriority != nil {
+		return *settings.DefaultPriority, nil
+	}
+
+	return workerdb.PriorityNormal, nil
+}
+
+// GetPriorityCategory returns the category that tickets with the given priority should be placed in, if one has been
+// configured
+func GetPriorityCategory(ctx context.Context, guildId uint64, priority workerdb.Priority) (*uint64, error) {
+	return dbclient.WorkerDb.PriorityCategories.Get(ctx, guildId, priority)
+}
+
+// GetPriorityParentId returns the category that a ticket channel should be moved to after its priority is changed, or
+// nil if it should stay where it is. If no category is configured for the new priority, but the channel is currently
+// in the category of a different priority, it is moved back to the panel's category, or the default category.
+func GetPriorityParentId(ctx context.Context, guildId uint64, panel *database.Panel, priority workerdb.Priority, currentParentId uint64) (*uint64, error) {
+	categories, err := dbclient.WorkerDb.PriorityCategories.GetAll(ctx, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	if categoryId, ok := categories[priority]; ok {
+		if categoryId == currentParentId {
+			return nil, nil
+		}
+
+		return &categoryId, nil
+	}
+
+	inPriorityCategory := false
+	for _, categoryId := range categories {
+		if categoryId == currentParentId {
+			inPriorityCategory = true
+			break
+		}
+	}
+
+	if !inPriorityCategory {
+		return nil, nil
+	}
+
+	var categoryId uint64
+	if panel != nil && panel.TargetCategory != 0 {
+		categoryId = panel.TargetCategory
+	} else {
+		categoryId, err = dbclient.Client.ChannelCategory.Get(ctx, guildId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if categoryId == 0 || categoryId == currentParentId {
+		return nil, nil
+	}
+
+	return &categoryId, nil
+}
@@ -0,0 +1,98 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"github.com/TicketsBot/database"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/redis"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/bot/workerdb"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/channel/message"
+	"github.com/rxdn/gdl/rest"
+	"time"
+)
+
+// ReminderTimerKey is the Redis sorted set that reminders are scheduled in
+const ReminderTimerKey = "tickets:reminders:timers"
+
+const (
+	MaxReminderDuration = time.Hour * 24 * 30
+	MaxReminderLength   = 1000
+
+	// MaxTicketReminders is the number of pending reminders a ticket may have, which also keeps /remind list within
+	// the number of cancel buttons that fit in a message
+	MaxTicketReminders = 10
+)
+
+type ReminderTimer struct {
+	Id int `json:"id"`
+}
+
+// ScheduleReminder stores the reminder and schedules it to fire at reminder.RemindAt, returning its ID
+func ScheduleReminder(ctx context.Context, reminder workerdb.TicketReminder) (int, error) {
+	id, err := dbclient.WorkerDb.TicketReminders.Create(ctx, reminder)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := redis.ScheduleTimer(ctx, ReminderTimerKey, ReminderTimer{Id: id}, reminder.RemindAt); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+// CancelReminder returns false if the reminder had already fired or been cancelled
+func CancelReminder(ctx context.Context, id int) (bool, error) {
+	deleted, err := dbclient.WorkerDb.TicketReminders.Delete(ctx, id)
+	if err != nil || !deleted {
+		return false, err
+	}
+
+	if err := redis.CancelTimer(ctx, ReminderTimerKey, ReminderTimer{Id: id}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// CancelTicketReminders cancels all pending reminders for the ticket, for when it is closed
+func CancelTicketReminders(ctx context.Context, guildId uint64, ticketId int) error {
+	ids, err := dbclient.WorkerDb.TicketReminders.DeleteByTicket(ctx, guildId, ticketId)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range ids {
+		if err := redis.CancelTimer(ctx, ReminderTimerKey, ReminderTimer{Id: id}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// HandleReminderTimer posts the reminder in the ticket, pinging the user it was set for
+func HandleReminderTimer(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket, reminder workerdb.TicketReminder) error {
+	pingUserId := reminder.CreatedBy
+	if reminder.UserId != nil {
+		pingUserId = *reminder.UserId
+	}
+
+	e := utils.BuildEmbed(cmd, customisation.Green, i18n.TitleReminder, i18n.MessageReminder, nil, reminder.CreatedBy, reminder.Message)
+
+	data := rest.CreateMessageData{
+		Content: fmt.Sprintf("<@%d>", pingUserId),
+		Embeds:  utils.Slice(e),
+		AllowedMentions: message.AllowedMention{
+			Users: []uint64{pingUserId},
+		},
+	}
+
+	_, err := cmd.Worker().CreateMessageComplex(*ticket.ChannelId, data)
+	return err
+}
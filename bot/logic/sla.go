@@ -0,0 +1,178 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"github.com/TicketsBot/database"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/redis"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/bot/workerdb"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/channel/message"
+	"github.com/rxdn/gdl/rest"
+	"time"
+)
+
+// SlaTimerKey is the Redis sorted set that SLA timers are scheduled in
+const SlaTimerKey = "tickets:sla:timers"
+
+// Staff are warned that a ticket is about to breach its SLA once this fraction of the target has elapsed
+const slaWarningThreshold = 0.8
+
+type SlaTimer struct {
+	GuildId  uint64           `json:"guild_id"`
+	TicketId int              `json:"ticket_id"`
+	Type     workerdb.SlaType `json:"type"`
+	Warning  bool             `json:"warning"`
+}
+
+// ScheduleSlaTimers schedules the warning and breach timers for each SLA target of the ticket's panel
+func ScheduleSlaTimers(ctx context.Context, ticket database.Ticket) error {
+	if ticket.PanelId == nil {
+		return nil
+	}
+
+	sla, ok, err := dbclient.WorkerDb.PanelSla.Get(ctx, *ticket.PanelId)
+	if err != nil || !ok {
+		return err
+	}
+
+	for _, slaType := range []workerdb.SlaType{workerdb.SlaFirstResponse, workerdb.SlaResolution} {
+		target := sla.Target(slaType)
+		if target == nil {
+			continue
+		}
+
+		timer := SlaTimer{
+			GuildId:  ticket.GuildId,
+			TicketId: ticket.Id,
+			Type:     slaType,
+		}
+
+		if err := redis.ScheduleTimer(ctx, SlaTimerKey, timer, ticket.OpenTime.Add(*target)); err != nil {
+			return err
+		}
+
+		timer.Warning = true
+		warnAt := ticket.OpenTime.Add(time.Duration(float64(*target) * slaWarningThreshold))
+		if err := redis.ScheduleTimer(ctx, SlaTimerKey, timer, warnAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// HandleSlaTimer warns staff that the ticket is about to breach its SLA, or records the breach and posts a notice in
+// the ticket, if the target has not already been met
+func HandleSlaTimer(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket, timer SlaTimer) error {
+	if !ticket.Open || ticket.ChannelId == nil || ticket.PanelId == nil {
+		return nil
+	}
+
+	if timer.Type == workerdb.SlaFirstResponse {
+		responded, err := dbclient.Client.FirstResponseTime.HasResponse(ctx, ticket.GuildId, ticket.Id)
+		if err != nil {
+			return err
+		}
+
+		if responded {
+			return nil
+		}
+	}
+
+	// The targets may have been changed or removed since the timer was scheduled
+	sla, ok, err := dbclient.WorkerDb.PanelSla.Get(ctx, *ticket.PanelId)
+	if err != nil || !ok {
+		return err
+	}
+
+	target := sla.Target(timer.Type)
+	if target == nil {
+		return nil
+	}
+
+	breachAt := ticket.OpenTime.Add(*target)
+
+	if timer.Warning {
+		return sendSlaWarning(ctx, cmd, ticket, sla, timer.Type, breachAt)
+	}
+
+	recorded, err := dbclient.WorkerDb.SlaBreaches.Add(ctx, ticket.GuildId, ticket.Id, timer.Type, breachAt)
+	if err != nil {
+		return err
+	}
+
+	// Another worker has already handled the breach
+	if !recorded {
+		return nil
+	}
+
+	var content i18n.MessageId
+	if timer.Type == workerdb.SlaFirstResponse {
+		content = i18n.MessageSlaBreachFirstResponse
+	} else {
+		content = i18n.MessageSlaBreachResolution
+	}
+
+	e := utils.BuildEmbed(cmd, customisation.Red, i18n.TitleSlaBreached, content, nil, utils.FormatTime(*target))
+	_, err = cmd.Worker().CreateMessageEmbed(*ticket.ChannelId, e)
+	return err
+}
+
+func sendSlaWarning(
+	ctx context.Context,
+	cmd registry.CommandContext,
+	ticket database.Ticket,
+	sla workerdb.PanelSla,
+	slaType workerdb.SlaType,
+	breachAt time.Time,
+) error {
+	var roles []uint64
+	if sla.EscalationRoleId != nil {
+		roles = []uint64{*sla.EscalationRoleId}
+	} else {
+		panel, err := dbclient.Client.Panel.GetById(ctx, *ticket.PanelId)
+		if err != nil {
+			return err
+		}
+
+		roles, err = GetOnCallRoles(ctx, ticket.GuildId, &panel)
+		if err != nil {
+			return err
+		}
+	}
+
+	// There is nobody to escalate to
+	if len(roles) == 0 {
+		return nil
+	}
+
+	var mentions string
+	for _, roleId := range roles {
+		mentions += fmt.Sprintf("<@&%d>", roleId)
+	}
+
+	var content i18n.MessageId
+	if slaType == workerdb.SlaFirstResponse {
+		content = i18n.MessageSlaWarningFirstResponse
+	} else {
+		content = i18n.MessageSlaWarningResolution
+	}
+
+	data := rest.CreateMessageData{
+		Content: mentions,
+		Embeds: utils.Slice(
+			utils.BuildEmbed(cmd, customisation.Orange, i18n.TitleSlaWarning, content, nil, fmt.Sprintf("<t:%d:R>", breachAt.Unix())),
+		),
+		AllowedMentions: message.AllowedMention{
+			Roles: roles,
+		},
+	}
+
+	_, err := cmd.Worker().CreateMessageComplex(*ticket.ChannelId, data)
+	return err
+}
@@ -0,0 +1,169 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"github.com/TicketsBot/database"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/redis"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/bot/workerdb"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/channel/message"
+	"github.com/rxdn/gdl/rest"
+	"time"
+)
+
+// SnoozeTimerKey is the Redis sorted set that snooze expiry timers are scheduled in
+const SnoozeTimerKey = "tickets:snooze:timers"
+
+const MaxSnoozeDuration = time.Hour * 24 * 30
+
+// SnoozeTimer deliberately does not contain the expiry time, so that snoozing a ticket again reschedules the existing
+// timer rather than adding a second one
+type SnoozeTimer struct {
+	GuildId  uint64 `json:"guild_id"`
+	TicketId int    `json:"ticket_id"`
+}
+
+// SnoozeTicket suspends autoclose for the ticket until the given time, moving the channel to the snooze category if
+// one has been configured
+func SnoozeTicket(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket, until time.Time, reason *string) error {
+	snooze := workerdb.TicketSnooze{
+		GuildId:      ticket.GuildId,
+		TicketId:     ticket.Id,
+		SnoozedUntil: until,
+		SnoozedBy:    cmd.UserId(),
+		Reason:       reason,
+	}
+
+	existing, alreadySnoozed, err := dbclient.WorkerDb.TicketSnoozes.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return err
+	}
+
+	if alreadySnoozed {
+		// The channel has already been moved, so keep the original category to restore it to
+		snooze.PreviousParentId = existing.PreviousParentId
+	} else if !ticket.IsThread {
+		previousParentId, err := moveToSnoozeCategory(ctx, cmd, ticket)
+		if err != nil {
+			return err
+		}
+
+		snooze.PreviousParentId = previousParentId
+	}
+
+	if err := dbclient.WorkerDb.TicketSnoozes.Set(ctx, snooze); err != nil {
+		return err
+	}
+
+	return redis.ScheduleTimer(ctx, SnoozeTimerKey, SnoozeTimer{GuildId: ticket.GuildId, TicketId: ticket.Id}, until)
+}
+
+// moveToSnoozeCategory returns the category the channel was moved out of, or nil if it was not moved
+func moveToSnoozeCategory(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket) (*uint64, error) {
+	categoryId, err := dbclient.WorkerDb.SnoozeCategory.Get(ctx, ticket.GuildId)
+	if err != nil || categoryId == nil {
+		return nil, err
+	}
+
+	ch, err := cmd.Worker().GetChannel(*ticket.ChannelId)
+	if err != nil {
+		return nil, err
+	}
+
+	if ch.ParentId.Value == *categoryId {
+		return nil, nil
+	}
+
+	if _, err := cmd.Worker().ModifyChannel(*ticket.ChannelId, rest.ModifyChannelData{ParentId: *categoryId}); err != nil {
+		return nil, err
+	}
+
+	// ModifyChannelData omits a zero parent ID, so a channel without a category can't be moved back out of it
+	if ch.ParentId.Value == 0 {
+		return nil, nil
+	}
+
+	return utils.Ptr(ch.ParentId.Value), nil
+}
+
+// HandleSnoozeTimer un-snoozes the ticket, moving it back to its original category and pinging the claimer, or the
+// opener if the ticket is unclaimed. The ticket's last message time is reset, so that a ticket snoozed for longer than
+// the autoclose period is not closed straight away.
+func HandleSnoozeTimer(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket) error {
+	snooze, ok, err := dbclient.WorkerDb.TicketSnoozes.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil || !ok {
+		return err
+	}
+
+	// The ticket has been snoozed again since the timer was scheduled
+	if snooze.SnoozedUntil.After(time.Now()) {
+		return nil
+	}
+
+	if err := dbclient.WorkerDb.TicketSnoozes.Delete(ctx, ticket.GuildId, ticket.Id); err != nil {
+		return err
+	}
+
+	if !ticket.Open || ticket.ChannelId == nil {
+		return nil
+	}
+
+	if snooze.PreviousParentId != nil && !ticket.IsThread {
+		data := rest.ModifyChannelData{ParentId: *snooze.PreviousParentId}
+		if _, err := cmd.Worker().ModifyChannel(*ticket.ChannelId, data); err != nil {
+			return err
+		}
+	}
+
+	claimer, err := dbclient.Client.TicketClaims.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return err
+	}
+
+	pingUserId := ticket.UserId
+	if claimer != 0 {
+		pingUserId = claimer
+	}
+
+	e := utils.BuildEmbed(cmd, customisation.Green, i18n.TitleUnsnoozed, i18n.MessageUnsnoozed, nil)
+	if snooze.Reason != nil {
+		e = utils.BuildEmbed(cmd, customisation.Green, i18n.TitleUnsnoozed, i18n.MessageUnsnoozedReason, nil, *snooze.Reason)
+	}
+
+	data := rest.CreateMessageData{
+		Content: fmt.Sprintf("<@%d>", pingUserId),
+		Embeds:  utils.Slice(e),
+		AllowedMentions: message.AllowedMention{
+			Users: []uint64{pingUserId},
+		},
+	}
+
+	msg, err := cmd.Worker().CreateMessageComplex(*ticket.ChannelId, data)
+	if err != nil {
+		return err
+	}
+
+	return resetLastMessage(ctx, ticket, msg.Id)
+}
+
+// resetLastMessage restarts the ticket's inactivity period from the given message. The message listener ignores bot
+// messages, so the ping would not do this by itself. Who is waiting on a response is unchanged.
+func resetLastMessage(ctx context.Context, ticket database.Ticket, messageId uint64) error {
+	lastMessage, err := dbclient.Client.TicketLastMessage.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return err
+	}
+
+	userId, isStaff := ticket.UserId, false
+	if lastMessage.UserId != nil {
+		userId = *lastMessage.UserId
+		isStaff = lastMessage.UserIsStaff != nil && *lastMessage.UserIsStaff
+	}
+
+	return dbclient.Client.TicketLastMessage.Set(ctx, ticket.GuildId, ticket.Id, messageId, userId, isStaff)
+}
@@ -0,0 +1,303 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	permcache "github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/database"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/redis"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/bot/workerdb"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/rxdn/gdl/objects/channel"
+	"github.com/rxdn/gdl/rest/request"
+	"time"
+)
+
+// AccessExpiryTimerKey is the Redis sorted set that temporary /add expiry timers are scheduled in
+const AccessExpiryTimerKey = "tickets:access_expiry:timers"
+
+const (
+	MaxTemporaryAccessDuration = time.Hour * 24 * 30
+
+	// MaxThreadRoleMembers is the most members that adding a role to a thread ticket will add, as each member has to
+	// be added to the thread individually
+	MaxThreadRoleMembers = 25
+
+	// How long to wait before retrying the removal of access that could not be removed when it expired
+	accessExpiryRetryDelay = time.Minute * 5
+)
+
+// AccessExpiryTimer does not contain the expiry time, so that adding the same user or role again reschedules the
+// existing timer
+type AccessExpiryTimer struct {
+	GuildId  uint64 `json:"guild_id"`
+	TicketId int    `json:"ticket_id"`
+	TargetId uint64 `json:"target_id"`
+}
+
+// AddRoleToTicket gives the role access to a channel ticket, or adds its cached members to a thread ticket. The IDs of
+// the members that were added to the thread are returned.
+func AddRoleToTicket(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket, roleId uint64) ([]uint64, error) {
+	if !ticket.IsThread {
+		additionalPermissions, err := dbclient.Client.TicketPermissions.Get(ctx, ticket.GuildId)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, cmd.Worker().EditChannelPermissions(*ticket.ChannelId, BuildRoleOverwrite(roleId, additionalPermissions))
+	}
+
+	members, err := GetCachedRoleMembers(ctx, cmd, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, userId := range members {
+		if err := cmd.Worker().AddThreadMember(*ticket.ChannelId, userId); err != nil {
+			return nil, err
+		}
+	}
+
+	return members, nil
+}
+
+// GetCachedRoleMembers returns the IDs of the members of the role, excluding the bot. Members are only found if they
+// are cached.
+func GetCachedRoleMembers(ctx context.Context, cmd registry.CommandContext, roleId uint64) ([]uint64, error) {
+	members, err := cmd.Worker().Cache.GetGuildMembers(ctx, cmd.GuildId(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	var userIds []uint64
+	for _, member := range members {
+		if member.HasRole(roleId) && member.User.Id != cmd.Worker().BotId {
+			userIds = append(userIds, member.User.Id)
+		}
+	}
+
+	return userIds, nil
+}
+
+// CapturePreviousAccess records the access that the user or role has to the ticket before /add changes it, so that
+// only what /add changed is undone when the access expires. If the target already has temporary access, the access it
+// had before that is kept instead.
+func CapturePreviousAccess(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket, access *workerdb.TicketTemporaryAccess) error {
+	existing, ok, err := dbclient.WorkerDb.TicketTemporaryAccess.Get(ctx, ticket.GuildId, ticket.Id, access.TargetId)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		access.PreviousAllow = existing.PreviousAllow
+		access.PreviousDeny = existing.PreviousDeny
+		access.WasMember = existing.WasMember
+		access.AddedUserIds = existing.AddedUserIds
+		return nil
+	}
+
+	if !access.IsRole {
+		members, err := dbclient.Client.TicketMembers.Get(ctx, ticket.GuildId, ticket.Id)
+		if err != nil {
+			return err
+		}
+
+		access.WasMember = utils.Contains(members, access.TargetId)
+	}
+
+	if ticket.IsThread {
+		return nil
+	}
+
+	ch, err := cmd.Worker().GetChannel(*ticket.ChannelId)
+	if err != nil {
+		return err
+	}
+
+	for _, overwrite := range ch.PermissionOverwrites {
+		if overwrite.Id == access.TargetId {
+			access.PreviousAllow = utils.Ptr(overwrite.Allow)
+			access.PreviousDeny = utils.Ptr(overwrite.Deny)
+			break
+		}
+	}
+
+	return nil
+}
+
+// ScheduleAccessExpiry removes the user or role from the ticket at access.ExpiresAt
+func ScheduleAccessExpiry(ctx context.Context, access workerdb.TicketTemporaryAccess) error {
+	if err := dbclient.WorkerDb.TicketTemporaryAccess.Set(ctx, access); err != nil {
+		return err
+	}
+
+	timer := AccessExpiryTimer{GuildId: access.GuildId, TicketId: access.TicketId, TargetId: access.TargetId}
+	return redis.ScheduleTimer(ctx, AccessExpiryTimerKey, timer, access.ExpiresAt)
+}
+
+// ClearAccessExpiry makes access to the ticket permanent, for when a temporarily added user or role is added again
+// without a duration
+func ClearAccessExpiry(ctx context.Context, guildId uint64, ticketId int, targetId uint64) error {
+	if err := dbclient.WorkerDb.TicketTemporaryAccess.Delete(ctx, guildId, ticketId, targetId); err != nil {
+		return err
+	}
+
+	timer := AccessExpiryTimer{GuildId: guildId, TicketId: ticketId, TargetId: targetId}
+	return redis.CancelTimer(ctx, AccessExpiryTimerKey, timer)
+}
+
+// HandleAccessExpiryTimer removes the user or role from the ticket and posts a notice in it
+func HandleAccessExpiryTimer(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket, targetId uint64) error {
+	access, ok, err := dbclient.WorkerDb.TicketTemporaryAccess.Get(ctx, ticket.GuildId, ticket.Id, targetId)
+	if err != nil || !ok {
+		return err
+	}
+
+	// The access has been extended since the timer was scheduled
+	if access.ExpiresAt.After(time.Now()) {
+		return nil
+	}
+
+	if !ticket.Open || ticket.ChannelId == nil {
+		return dbclient.WorkerDb.TicketTemporaryAccess.Delete(ctx, ticket.GuildId, ticket.Id, targetId)
+	}
+
+	var mention string
+	if access.IsRole {
+		err = removeRoleFromTicket(ctx, cmd, ticket, &access)
+		mention = fmt.Sprintf("<@&%d>", targetId)
+	} else {
+		err = removeUserFromTicket(ctx, cmd, ticket, access)
+		mention = fmt.Sprintf("<@%d>", targetId)
+	}
+
+	// Keep the record and try again later, rather than leaving whatever could not be removed with access for good
+	if err != nil {
+		access.ExpiresAt = time.Now().Add(accessExpiryRetryDelay)
+		if scheduleErr := ScheduleAccessExpiry(ctx, access); scheduleErr != nil {
+			return errors.Join(err, scheduleErr)
+		}
+
+		return err
+	}
+
+	if err := dbclient.WorkerDb.TicketTemporaryAccess.Delete(ctx, ticket.GuildId, ticket.Id, targetId); err != nil {
+		return err
+	}
+
+	e := utils.BuildEmbed(cmd, customisation.Orange, i18n.TitleRemove, i18n.MessageAddExpired, nil, mention)
+	_, err = cmd.Worker().CreateMessageEmbed(*ticket.ChannelId, e)
+	return err
+}
+
+func removeUserFromTicket(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket, access workerdb.TicketTemporaryAccess) error {
+	userId := access.TargetId
+	if userId == ticket.UserId {
+		return nil
+	}
+
+	if !access.WasMember {
+		if err := dbclient.Client.TicketMembers.Delete(ctx, ticket.GuildId, ticket.Id, userId); err != nil {
+			return err
+		}
+	}
+
+	if ticket.IsThread {
+		if access.WasMember {
+			return nil
+		}
+
+		// The user is no longer in the thread if they have left the server
+		if err := cmd.Worker().RemoveThreadMember(*ticket.ChannelId, userId); err != nil && !isNotFound(err) {
+			return err
+		}
+
+		return nil
+	}
+
+	return restorePreviousOverwrite(cmd, ticket, access, channel.PermissionTypeMember)
+}
+
+// removeRoleFromTicket restores the role's overwrite on a channel ticket, or removes the members that adding the role
+// put in a thread ticket. Members that could not be removed are left in access.AddedUserIds.
+func removeRoleFromTicket(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket, access *workerdb.TicketTemporaryAccess) error {
+	if !ticket.IsThread {
+		return restorePreviousOverwrite(cmd, ticket, *access, channel.PermissionTypeRole)
+	}
+
+	// Members who were added individually should stay in the thread
+	ticketMembers, err := dbclient.Client.TicketMembers.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return err
+	}
+
+	var remaining []uint64
+	var errs []error
+	for _, userId := range access.AddedUserIds {
+		if userId == ticket.UserId || utils.Contains(ticketMembers, userId) {
+			continue
+		}
+
+		if err := removeThreadMember(ctx, cmd, ticket, userId); err != nil {
+			remaining = append(remaining, userId)
+			errs = append(errs, err)
+		}
+	}
+
+	access.AddedUserIds = remaining
+	return errors.Join(errs...)
+}
+
+// removeThreadMember removes a member that was added to a thread ticket by a role, unless they are staff. Members who
+// have since left the server are skipped.
+func removeThreadMember(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket, userId uint64) error {
+	member, err := cmd.Worker().GetGuildMember(ticket.GuildId, userId)
+	if err != nil {
+		if isNotFound(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	permLevel, err := permcache.GetPermissionLevel(ctx, utils.ToRetriever(cmd.Worker()), member, ticket.GuildId)
+	if err != nil {
+		return err
+	}
+
+	// Staff can be in the thread regardless of the role
+	if permLevel > permcache.Everyone {
+		return nil
+	}
+
+	if err := cmd.Worker().RemoveThreadMember(*ticket.ChannelId, userId); err != nil && !isNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func isNotFound(err error) bool {
+	var restError request.RestError
+	return errors.As(err, &restError) && restError.StatusCode == 404
+}
+
+// restorePreviousOverwrite puts back the overwrite that the target had before it was added. If it had none, the
+// overwrite is deleted rather than denying access, so staff who were added keep the access their roles give them.
+func restorePreviousOverwrite(cmd registry.CommandContext, ticket database.Ticket, access workerdb.TicketTemporaryAccess, overwriteType channel.PermissionOverwriteType) error {
+	if access.PreviousAllow == nil || access.PreviousDeny == nil {
+		return cmd.Worker().DeleteChannelPermissions(*ticket.ChannelId, access.TargetId)
+	}
+
+	return cmd.Worker().EditChannelPermissions(*ticket.ChannelId, channel.PermissionOverwrite{
+		Id:    access.TargetId,
+		Type:  overwriteType,
+		Allow: *access.PreviousAllow,
+		Deny:  *access.PreviousDeny,
+	})
+}
@@ -120,6 +120,7 @@ func BuildWelcomeMessageEmbed(
 	// Only custom integration placeholders for now - prevent making duplicate requests
 	additionalPlaceholders map[string]string,
 ) (*embed.Embed, error) {
+	var e *embed.Embed
 	if panel == nil || panel.WelcomeMessageEmbed == nil {
 		welcomeMessage, err := dbclient.Client.WelcomeMessages.Get(ctx, ticket.GuildId)
 		if err != nil {
@@ -133,7 +134,7 @@ func BuildWelcomeMessageEmbed(
 		// Replace variables
 		welcomeMessage = DoPlaceholderSubstitutions(ctx, welcomeMessage, cmd.Worker(), ticket, additionalPlaceholders)
 
-		return utils.BuildEmbedRaw(cmd.GetColour(customisation.Green), subject, welcomeMessage, nil, cmd.PremiumTier()), nil
+		e = utils.BuildEmbedRaw(cmd.GetColour(customisation.Green), subject, welcomeMessage, nil, cmd.PremiumTier())
 	} else {
 		data, err := dbclient.Client.Embeds.GetEmbed(ctx, *panel.WelcomeMessageEmbed)
 		if err != nil {
@@ -145,9 +146,16 @@ func BuildWelcomeMessageEmbed(
 			return nil, err
 		}
 
-		e := BuildCustomEmbed(ctx, cmd.Worker(), ticket, data, fields, cmd.PremiumTier() == premium.None, additionalPlaceholders)
-		return e, nil
+		e = BuildCustomEmbed(ctx, cmd.Worker(), ticket, data, fields, cmd.PremiumTier() == premium.None, additionalPlaceholders)
 	}
+
+	claimers, err := GetClaimers(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	setClaimedByField(cmd, e, claimers)
+	return e, nil
 }
 
 func DoPlaceholderSubstitutions(
@@ -472,6 +480,20 @@ func NewGroupSubstitutor(placeholders []string, f GroupSubstitutionFunc) GroupSu
 }
 
 var groupSubstitutions = []GroupSubstitutor{
+	NewGroupSubstitutor([]string{"priority", "priority_emoji"},
+		func(ctx context.Context, worker *worker.Context, ticket database.Ticket) map[string]string {
+			priority, err := dbclient.WorkerDb.TicketPriority.Get(ctx, ticket.GuildId, ticket.Id)
+			if err != nil {
+				sentry.Error(err)
+				return nil
+			}
+
+			return map[string]string{
+				"priority":       priority.String(),
+				"priority_emoji": PriorityEmoji(priority),
+			}
+		},
+	),
 	NewGroupSubstitutor([]string{"roblox_username", "roblox_id", "roblox_display_name", "roblox_profile_url", "roblox_account_age", "roblox_account_created"},
 		func(ctx context.Context, worker *worker.Context, ticket database.Ticket) map[string]string {
 			user, err := integrations.Bloxlink.GetRobloxUser(ctx, ticket.UserId)
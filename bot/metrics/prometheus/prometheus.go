@@ -37,6 +37,17 @@ var (
 	KafkaBatchSize = newHistogram("kafka_batch_size")
 	KafkaMessages  = newHistogramVec("kafka_messages", "topic")
 
+	EventRetries       = newCounterVec("event_retries", "source")
+	DeadLetteredEvents = newCounterVec("dead_lettered_events", "source", "retryable")
+
+	OrderedQueuedEvents = newGauge("ordered_queued_events")
+	OrderedActiveKeys   = newGauge("ordered_active_keys")
+	OrderedQueueWait    = newHistogram("ordered_queue_wait")
+	OrderedBackpressure = newCounterVec("ordered_backpressure", "reason")
+
+	DuplicateEvents       = newCounterVec("duplicate_events", "source")
+	DuplicateInteractions = newCounterVec("duplicate_interactions", "cached")
+
 	CategoryUpdates = newCounter("category_updates")
 )
 
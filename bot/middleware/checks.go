@@ -0,0 +1,213 @@
+package middleware
+
+import (
+	"fmt"
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/common/premium"
+	"github.com/TicketsBot/common/sentry"
+	"github.com/TicketsBot/worker/bot/blacklist"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/bot/logic"
+	"github.com/TicketsBot/worker/bot/metrics/prometheus"
+	"github.com/TicketsBot/worker/bot/metrics/statsd"
+	"github.com/TicketsBot/worker/bot/redis"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/i18n"
+	"strings"
+	"time"
+)
+
+// GuildBlacklist stops invocations in guilds that are globally blacklisted
+func GuildBlacklist(next Handler) Handler {
+	return func(inv *Invocation) {
+		if guildId := inv.Cmd.GuildId(); guildId != 0 && blacklist.IsGuildBlacklisted(guildId) {
+			inv.Cmd.Reply(customisation.Red, i18n.TitleBlacklisted, i18n.MessageBlacklisted)
+			return
+		}
+
+		next(inv)
+	}
+}
+
+// UserBlacklist stops invocations by users who are blacklisted, either globally or in the guild
+func UserBlacklist(next Handler) Handler {
+	return func(inv *Invocation) {
+		blacklisted, err := inv.Cmd.IsBlacklisted(inv.Ctx)
+		if err != nil {
+			inv.Cmd.HandleError(err)
+			return
+		}
+
+		if blacklisted {
+			inv.Cmd.Reply(customisation.Red, i18n.TitleBlacklisted, i18n.MessageBlacklisted)
+			return
+		}
+
+		next(inv)
+	}
+}
+
+// CommandOverrides applies the guild's per-command allow and deny rules, and so must come before PermissionLevel. An
+// allow rule lets the user run the command regardless of the permission level it requires.
+func CommandOverrides(next Handler) Handler {
+	return func(inv *Invocation) {
+		guildId := inv.Cmd.GuildId()
+		if inv.Type != InvocationCommand || guildId == 0 {
+			next(inv)
+			return
+		}
+
+		settings, err := inv.CommandSettings()
+		if err != nil {
+			inv.Cmd.HandleError(err)
+			return
+		}
+
+		overrides := settings.Overrides[inv.Name]
+
+		if len(overrides) == 0 {
+			next(inv)
+			return
+		}
+
+		member, err := inv.Cmd.Member()
+		if err != nil {
+			inv.Cmd.HandleError(err)
+			return
+		}
+
+		switch logic.ResolveCommandOverride(overrides, guildId, inv.Cmd.UserId(), member.Roles) {
+		case logic.CommandOverrideAllow:
+			inv.Requirements.PermissionLevel = permission.Everyone
+		case logic.CommandOverrideDeny:
+			permLevel, err := inv.UserPermissionLevel()
+			if err != nil {
+				inv.Cmd.HandleError(err)
+				return
+			}
+
+			// Admins can't be locked out of commands, including /permissions itself
+			if permLevel < permission.Admin {
+				inv.Cmd.Reply(customisation.Red, i18n.Error, i18n.MessageNoPermission)
+				return
+			}
+		}
+
+		next(inv)
+	}
+}
+
+// PermissionLevel stops invocations by users below the required permission level
+func PermissionLevel(next Handler) Handler {
+	return func(inv *Invocation) {
+		if inv.Requirements.PermissionLevel > permission.Everyone {
+			permLevel, err := inv.UserPermissionLevel()
+			if err != nil {
+				inv.Cmd.HandleError(err)
+				return
+			}
+
+			if permLevel < inv.Requirements.PermissionLevel {
+				inv.Cmd.Reply(customisation.Red, i18n.Error, i18n.MessageNoPermission)
+				return
+			}
+		}
+
+		next(inv)
+	}
+}
+
+// BotStaff stops invocations of commands reserved for bot admins and helpers by anyone else
+func BotStaff(next Handler) Handler {
+	return func(inv *Invocation) {
+		if inv.Requirements.AdminOnly && !utils.IsBotAdmin(inv.Cmd.UserId()) {
+			inv.Cmd.Reply(customisation.Red, i18n.Error, i18n.MessageOwnerOnly)
+			return
+		}
+
+		if inv.Requirements.HelperOnly && !utils.IsBotHelper(inv.Cmd.UserId()) {
+			inv.Cmd.Reply(customisation.Red, i18n.Error, i18n.MessageNoPermission)
+			return
+		}
+
+		next(inv)
+	}
+}
+
+// Premium stops invocations of premium only commands in guilds without premium
+func Premium(next Handler) Handler {
+	return func(inv *Invocation) {
+		if inv.Requirements.PremiumOnly && inv.Cmd.PremiumTier() == premium.None {
+			inv.Cmd.Reply(customisation.Red, i18n.TitlePremiumOnly, i18n.MessagePremium)
+			return
+		}
+
+		next(inv)
+	}
+}
+
+// Cooldown stops users from using a command or component more often than its cooldown allows. Guilds may override the
+// cooldowns of commands.
+func Cooldown(next Handler) Handler {
+	return func(inv *Invocation) {
+		guildId := inv.Cmd.GuildId()
+
+		cooldown := inv.Requirements.Cooldown
+
+		bucket := inv.Name
+		if cooldown.Bucket != "" {
+			bucket = cooldown.Bucket
+		}
+
+		if inv.Type == InvocationCommand && guildId != 0 {
+			settings, err := inv.CommandSettings()
+			if err != nil {
+				inv.Cmd.HandleError(err)
+				return
+			}
+
+			if override, ok := settings.Cooldowns[bucket]; ok {
+				cooldown.Uses = override.Uses
+				cooldown.Window = override.Window
+			}
+		}
+
+		if !cooldown.Enabled() {
+			next(inv)
+			return
+		}
+
+		retryAfter, err := redis.TakeCooldownToken(inv.Ctx, guildId, inv.Cmd.UserId(), bucket, cooldown.Uses, cooldown.Window)
+		if err != nil {
+			// Don't stop people using the bot while Redis is having issues
+			sentry.ErrorWithContext(err, inv.Cmd.ToErrorContext())
+			next(inv)
+			return
+		}
+
+		if retryAfter > 0 {
+			// Round up, so that the user isn't told to try again before they can
+			retryAt := time.Now().Add(retryAfter + time.Second - 1)
+			inv.Cmd.Reply(customisation.Red, i18n.TitleCooldown, i18n.MessageCooldown, fmt.Sprintf("<t:%d:R>", retryAt.Unix()))
+			return
+		}
+
+		next(inv)
+	}
+}
+
+// CommandMetrics counts the commands that pass the checks before it
+func CommandMetrics(next Handler) Handler {
+	return func(inv *Invocation) {
+		if inv.Type == InvocationCommand {
+			statsd.Client.IncrementKey(statsd.KeySlashCommands)
+			statsd.Client.IncrementKey(statsd.KeyCommands)
+
+			// Subcommands are counted under their root command
+			root, _, _ := strings.Cut(inv.Name, " ")
+			prometheus.LogCommand(root)
+		}
+
+		next(inv)
+	}
+}
@@ -0,0 +1,113 @@
+package middleware
+
+import (
+	"context"
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/worker/bot/command"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/logic"
+)
+
+type InvocationType int
+
+const (
+	InvocationCommand InvocationType = iota
+	InvocationButton
+	InvocationSelectMenu
+	InvocationModal
+)
+
+// Requirements are the checks declared by the command or component handler being invoked
+type Requirements struct {
+	PermissionLevel permission.PermissionLevel
+	PremiumOnly     bool
+	AdminOnly       bool
+	HelperOnly      bool
+	Cooldown        command.Cooldown
+}
+
+// Invocation is a command or component interaction that has been routed to a handler, but not yet executed
+type Invocation struct {
+	// Ctx bounds the time spent on checks, which must finish before the interaction is responded to
+	Ctx  context.Context
+	Cmd  registry.InteractionContext
+	Type InvocationType
+	// Name is the full name of the command including any subcommands, e.g. "claim self", or the type of the component
+	// handler
+	Name         string
+	Requirements Requirements
+
+	permissionLevel *permission.PermissionLevel
+	commandSettings *logic.CommandSettings
+}
+
+func NewInvocation(
+	ctx context.Context,
+	cmd registry.InteractionContext,
+	invocationType InvocationType,
+	name string,
+	requirements Requirements,
+) *Invocation {
+	return &Invocation{
+		Ctx:          ctx,
+		Cmd:          cmd,
+		Type:         invocationType,
+		Name:         name,
+		Requirements: requirements,
+	}
+}
+
+// WithPermissionLevel sets the user's permission level, if it has already been fetched
+func (i *Invocation) WithPermissionLevel(level permission.PermissionLevel) *Invocation {
+	i.permissionLevel = &level
+	return i
+}
+
+// UserPermissionLevel returns the permission level of the user, only fetching it once per invocation
+func (i *Invocation) UserPermissionLevel() (permission.PermissionLevel, error) {
+	if i.permissionLevel != nil {
+		return *i.permissionLevel, nil
+	}
+
+	level, err := i.Cmd.UserPermissionLevel(i.Ctx)
+	if err != nil {
+		return permission.Everyone, err
+	}
+
+	i.permissionLevel = &level
+	return level, nil
+}
+
+// CommandSettings returns the guild's command permission overrides and cooldowns, only fetching them once per
+// invocation
+func (i *Invocation) CommandSettings() (logic.CommandSettings, error) {
+	if i.commandSettings != nil {
+		return *i.commandSettings, nil
+	}
+
+	settings, err := logic.GetCommandSettings(i.Ctx, i.Cmd.GuildId())
+	if err != nil {
+		return logic.CommandSettings{}, err
+	}
+
+	i.commandSettings = &settings
+	return settings, nil
+}
+
+// Handler executes an invocation
+type Handler func(inv *Invocation)
+
+// Middleware wraps a handler. To stop the invocation, a middleware replies to the user and does not call next.
+type Middleware func(next Handler) Handler
+
+// Chain is a list of middleware, outermost first
+type Chain []Middleware
+
+// Then returns a handler that runs the invocation through each middleware in the chain before calling h
+func (c Chain) Then(h Handler) Handler {
+	for i := len(c) - 1; i >= 0; i-- {
+		h = c[i](h)
+	}
+
+	return h
+}
@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"context"
+	"github.com/TicketsBot/common/permission"
+	"github.com/TicketsBot/common/premium"
+	"github.com/TicketsBot/worker/bot/command/registry"
+	"github.com/TicketsBot/worker/bot/customisation"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+// stubContext implements only the parts of the interaction context that the checks use
+type stubContext struct {
+	registry.InteractionContext
+
+	permissionLevel   permission.PermissionLevel
+	permissionLookups int
+	premiumTier       premium.PremiumTier
+	replies           []i18n.MessageId
+}
+
+func (c *stubContext) UserPermissionLevel(context.Context) (permission.PermissionLevel, error) {
+	c.permissionLookups++
+	return c.permissionLevel, nil
+}
+
+func (c *stubContext) PremiumTier() premium.PremiumTier {
+	return c.premiumTier
+}
+
+func (c *stubContext) Reply(_ customisation.Colour, _, content i18n.MessageId, _ ...interface{}) {
+	c.replies = append(c.replies, content)
+}
+
+func run(chain Chain, cmd *stubContext, requirements Requirements) bool {
+	var executed bool
+	chain.Then(func(*Invocation) {
+		executed = true
+	})(NewInvocation(context.Background(), cmd, InvocationCommand, "test", requirements))
+
+	return executed
+}
+
+func TestChainOrder(t *testing.T) {
+	var order []string
+	record := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(inv *Invocation) {
+				order = append(order, name)
+				next(inv)
+			}
+		}
+	}
+
+	require.True(t, run(Chain{record("first"), record("second")}, &stubContext{}, Requirements{}))
+	require.Equal(t, []string{"first", "second"}, order)
+}
+
+func TestPermissionLevel(t *testing.T) {
+	cmd := &stubContext{permissionLevel: permission.Support}
+
+	require.True(t, run(Chain{PermissionLevel}, cmd, Requirements{PermissionLevel: permission.Support}))
+	require.Empty(t, cmd.replies)
+
+	require.False(t, run(Chain{PermissionLevel}, cmd, Requirements{PermissionLevel: permission.Admin}))
+	require.Equal(t, []i18n.MessageId{i18n.MessageNoPermission}, cmd.replies)
+}
+
+func TestPermissionLevelFetchedOnce(t *testing.T) {
+	cmd := &stubContext{permissionLevel: permission.Admin}
+	chain := Chain{PermissionLevel, PermissionLevel}
+
+	require.True(t, run(chain, cmd, Requirements{PermissionLevel: permission.Admin}))
+	require.Equal(t, 1, cmd.permissionLookups)
+
+	// Commands for everyone don't need the level at all
+	cmd.permissionLookups = 0
+	require.True(t, run(chain, cmd, Requirements{}))
+	require.Zero(t, cmd.permissionLookups)
+}
+
+func TestPremium(t *testing.T) {
+	cmd := &stubContext{premiumTier: premium.None}
+
+	require.True(t, run(Chain{Premium}, cmd, Requirements{}))
+	require.False(t, run(Chain{Premium}, cmd, Requirements{PremiumOnly: true}))
+	require.Equal(t, []i18n.MessageId{i18n.MessagePremium}, cmd.replies)
+
+	cmd.premiumTier = premium.Premium
+	require.True(t, run(Chain{Premium}, cmd, Requirements{PremiumOnly: true}))
+}
@@ -15,16 +15,21 @@ var (
 var ErrNil = redis.Nil
 
 func Connect() error {
-	Client = redis.NewClient(&redis.Options{
+	UseClient(redis.NewClient(&redis.Options{
 		Network:      "tcp",
 		Addr:         config.Conf.Redis.Address,
 		Password:     config.Conf.Redis.Password,
 		PoolSize:     config.Conf.Redis.Threads,
 		MinIdleConns: config.Conf.Redis.Threads,
-	})
+	}))
+
+	return nil
+}
+
+// UseClient sets the client used by the package, and the locks built on it, e.g. to point them at a test server
+func UseClient(client *redis.Client) {
+	Client = client
 
 	pool := redsyncredis.NewPool(Client)
 	rs = redsync.New(pool)
-
-	return nil
 }
@@ -0,0 +1,52 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const closeAllRequestExpiry = time.Minute * 5
+
+func StoreCloseAllRequest(ctx context.Context, id string, request any) error {
+	encoded, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	return Client.Set(ctx, closeAllRequestKey(id), encoded, closeAllRequestExpiry).Err()
+}
+
+// TakeCloseAllRequest retrieves and deletes the request, so that it can only be confirmed once
+func TakeCloseAllRequest(ctx context.Context, id string, dest any) (bool, error) {
+	key := closeAllRequestKey(id)
+
+	tx := Client.TxPipeline()
+	get := tx.Get(ctx, key)
+	tx.Del(ctx, key)
+
+	if _, err := tx.Exec(ctx); err != nil && !errors.Is(err, ErrNil) {
+		return false, err
+	}
+
+	encoded, err := get.Bytes()
+	if err != nil {
+		if errors.Is(err, ErrNil) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	if err := json.Unmarshal(encoded, dest); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func closeAllRequestKey(id string) string {
+	return fmt.Sprintf("tickets:closeall:%s", id)
+}
@@ -0,0 +1,43 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const CommandSettingsCacheExpiry = time.Minute * 10
+
+var ErrCommandSettingsNotCached = errors.New("command settings not cached")
+
+func GetCommandSettings(ctx context.Context, guildId uint64, dest any) error {
+	encoded, err := Client.Get(ctx, commandSettingsKey(guildId)).Bytes()
+	if err != nil {
+		if errors.Is(err, ErrNil) {
+			return ErrCommandSettingsNotCached
+		}
+
+		return err
+	}
+
+	return json.Unmarshal(encoded, dest)
+}
+
+func SetCommandSettings(ctx context.Context, guildId uint64, settings any) error {
+	encoded, err := json.Marshal(settings)
+	if err != nil {
+		return err
+	}
+
+	return Client.Set(ctx, commandSettingsKey(guildId), encoded, CommandSettingsCacheExpiry).Err()
+}
+
+func DeleteCommandSettings(ctx context.Context, guildId uint64) error {
+	return Client.Del(ctx, commandSettingsKey(guildId)).Err()
+}
+
+func commandSettingsKey(guildId uint64) string {
+	return fmt.Sprintf("tickets:commandsettings:%d", guildId)
+}
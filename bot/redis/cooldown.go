@@ -0,0 +1,51 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"strconv"
+	"time"
+)
+
+// cooldownScript keeps a sorted set of the times the command was used within the window. If the limit has been
+// reached, it returns the number of milliseconds until the oldest use leaves the window, otherwise it records the use
+// and returns 0.
+var cooldownScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+
+redis.call("ZREMRANGEBYSCORE", KEYS[1], "-inf", now - window)
+
+if redis.call("ZCARD", KEYS[1]) >= limit then
+	local oldest = redis.call("ZRANGE", KEYS[1], 0, 0, "WITHSCORES")
+	return tonumber(oldest[2]) + window - now
+end
+
+redis.call("ZADD", KEYS[1], now, ARGV[4])
+redis.call("PEXPIRE", KEYS[1], window)
+
+return 0
+`)
+
+// TakeCooldownToken records a use of the command by the user, returning how long they must wait if they have already
+// used it uses times within the window
+func TakeCooldownToken(ctx context.Context, guildId, userId uint64, name string, uses int, window time.Duration) (time.Duration, error) {
+	key := fmt.Sprintf("tickets:cooldown:%d:%d:%s", guildId, userId, name)
+
+	now := time.Now()
+	member := strconv.FormatInt(now.UnixNano(), 10)
+
+	res, err := cooldownScript.Run(ctx, Client, []string{key}, now.UnixMilli(), window.Milliseconds(), uses, member).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	retryAfter, ok := res.(int64)
+	if !ok {
+		return 0, fmt.Errorf("cooldown script returned %v, not an int64", res)
+	}
+
+	return time.Duration(retryAfter) * time.Millisecond, nil
+}
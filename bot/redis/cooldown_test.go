@@ -0,0 +1,45 @@
+package redis_test
+
+import (
+	"context"
+	"github.com/TicketsBot/worker/bot/redis"
+	"github.com/TicketsBot/worker/testutil"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestTakeCooldownToken(t *testing.T) {
+	prev := redis.Client
+	redis.UseClient(testutil.NewRedis(t))
+	t.Cleanup(func() {
+		if prev != nil {
+			redis.UseClient(prev)
+		} else {
+			redis.Client = nil
+		}
+	})
+
+	ctx := context.Background()
+	window := time.Minute
+
+	for i := 0; i < 2; i++ {
+		retryAfter, err := redis.TakeCooldownToken(ctx, 1, 2, "tag", 2, window)
+		require.NoError(t, err)
+		require.Zero(t, retryAfter)
+	}
+
+	retryAfter, err := redis.TakeCooldownToken(ctx, 1, 2, "tag", 2, window)
+	require.NoError(t, err)
+	require.Greater(t, retryAfter, time.Duration(0))
+	require.LessOrEqual(t, retryAfter, window)
+
+	// Cooldowns are per user and per command
+	retryAfter, err = redis.TakeCooldownToken(ctx, 1, 3, "tag", 2, window)
+	require.NoError(t, err)
+	require.Zero(t, retryAfter)
+
+	retryAfter, err = redis.TakeCooldownToken(ctx, 1, 2, "closerequest", 2, window)
+	require.NoError(t, err)
+	require.Zero(t, retryAfter)
+}
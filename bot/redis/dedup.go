@@ -0,0 +1,67 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-redis/redis/v8"
+	"time"
+)
+
+const (
+	// Interaction tokens are only valid for 15 minutes, so there is no point responding to a redelivery after that
+	interactionDedupExpiry = time.Minute * 15
+	eventDedupExpiry       = time.Minute * 10
+
+	interactionPendingValue = "pending"
+)
+
+// ClaimInteraction marks the interaction as being processed, returning false if it has already been received
+func ClaimInteraction(ctx context.Context, interactionId uint64) (bool, error) {
+	return Client.SetNX(ctx, interactionDedupKey(interactionId), interactionPendingValue, interactionDedupExpiry).Result()
+}
+
+// StoreInteractionResponse stores the response sent for the interaction, so that it can be sent again if the
+// interaction is redelivered
+func StoreInteractionResponse(ctx context.Context, interactionId uint64, response []byte) error {
+	return Client.SetXX(ctx, interactionDedupKey(interactionId), response, redis.KeepTTL).Err()
+}
+
+// GetInteractionResponse returns the response sent for the interaction, or nil if the interaction is still being
+// processed or hasn't been seen before
+func GetInteractionResponse(ctx context.Context, interactionId uint64) ([]byte, error) {
+	res, err := Client.Get(ctx, interactionDedupKey(interactionId)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if string(res) == interactionPendingValue {
+		return nil, nil
+	}
+
+	return res, nil
+}
+
+// ClaimEvent marks the event as being processed, returning false if an event with the same fingerprint has already
+// been received
+func ClaimEvent(ctx context.Context, fingerprint string) (bool, error) {
+	return Client.SetNX(ctx, eventDedupKey(fingerprint), "1", eventDedupExpiry).Result()
+}
+
+// ReleaseEvent allows an event to be processed again, such as after it failed and has been sent to the dead-letter
+// queue to be replayed later
+func ReleaseEvent(ctx context.Context, fingerprint string) error {
+	return Client.Del(ctx, eventDedupKey(fingerprint)).Err()
+}
+
+func interactionDedupKey(interactionId uint64) string {
+	return fmt.Sprintf("tickets:dedup:interaction:%d", interactionId)
+}
+
+func eventDedupKey(fingerprint string) string {
+	return fmt.Sprintf("tickets:dedup:event:%s", fingerprint)
+}
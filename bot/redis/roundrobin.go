@@ -0,0 +1,12 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+)
+
+// NextRoundRobinIndex returns an ever-increasing counter for the panel, to be taken modulo the number of candidates
+func NextRoundRobinIndex(ctx context.Context, panelId int) (int64, error) {
+	key := fmt.Sprintf("tickets:autoassign:round_robin:%d", panelId)
+	return Client.Incr(ctx, key).Result()
+}
@@ -3,6 +3,7 @@ package redis
 import (
 	"fmt"
 	"github.com/TicketsBot/common/utils"
+	"github.com/TicketsBot/worker/config"
 	"github.com/go-redis/redis/v8"
 	"time"
 )
@@ -31,13 +32,14 @@ end
 return success
 `)
 
-var TicketOpenLimit = 10
-var TicketOpenLimitInterval = time.Second * 30
-
+// The limit is read from the live settings, so that it can be changed without a restart
 func TakeTicketRateLimitToken(client *redis.Client, guildId uint64) (bool, error) {
 	key := fmt.Sprintf("tickets:openratelimit:%d", guildId)
 
-	res, err := script.Run(utils.DefaultContext(), client, []string{key}, TicketOpenLimit, TicketOpenLimitInterval.Seconds()).Result()
+	settings := config.Live()
+	interval := int64(settings.TicketRateLimitInterval / time.Second)
+
+	res, err := script.Run(utils.DefaultContext(), client, []string{key}, settings.TicketRateLimit, interval).Result()
 	if err != nil {
 		return false, err
 	}
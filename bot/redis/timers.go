@@ -0,0 +1,51 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/go-redis/redis/v8"
+	"strconv"
+	"time"
+)
+
+// Timers are stored in a sorted set, scored by the unix time in milliseconds that they are due at. The member is the
+// JSON encoded payload, so scheduling the same payload twice only creates one timer.
+
+// popDueTimers removes and returns up to ARGV[2] members with a score <= ARGV[1]. Doing this in a script means that
+// each timer is only handed to one worker.
+var popDueTimers = redis.NewScript(`
+local due = redis.call('ZRANGEBYSCORE', KEYS[1], '-inf', ARGV[1], 'LIMIT', 0, ARGV[2])
+if #due > 0 then
+	redis.call('ZREM', KEYS[1], unpack(due))
+end
+return due
+`)
+
+// ScheduleTimer schedules the payload to be delivered to the listener for the key at the given time
+func ScheduleTimer(ctx context.Context, key string, payload any, at time.Time) error {
+	marshalled, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	return Client.ZAdd(ctx, key, &redis.Z{
+		Score:  float64(at.UnixMilli()),
+		Member: string(marshalled),
+	}).Err()
+}
+
+// CancelTimer removes a timer with the same payload, if one is scheduled
+func CancelTimer(ctx context.Context, key string, payload any) error {
+	marshalled, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	return Client.ZRem(ctx, key, string(marshalled)).Err()
+}
+
+// PopDueTimers removes and returns up to limit timers that are due at or before now
+func PopDueTimers(ctx context.Context, key string, now time.Time, limit int) ([]string, error) {
+	args := []interface{}{strconv.FormatInt(now.UnixMilli(), 10), limit}
+	return popDueTimers.Run(ctx, Client, []string{key}, args...).StringSlice()
+}
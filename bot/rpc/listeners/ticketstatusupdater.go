@@ -6,6 +6,7 @@ import (
 	"github.com/TicketsBot/common/rpc"
 	"github.com/TicketsBot/common/rpc/model"
 	"github.com/TicketsBot/worker"
+	"github.com/TicketsBot/worker/bot/lifecycle"
 	"github.com/TicketsBot/worker/bot/metrics/prometheus"
 	"github.com/TicketsBot/worker/bot/redis"
 	"github.com/rxdn/gdl/cache"
@@ -16,25 +17,38 @@ import (
 
 type TicketStatusUpdater struct {
 	*BaseListener
-	logger *zap.Logger
+	logger  *zap.Logger
+	manager *lifecycle.Manager
 }
 
 var _ rpc.Listener = (*TicketStatusUpdater)(nil)
 
-func NewTicketStatusUpdater(cache *cache.PgCache, logger *zap.Logger) *TicketStatusUpdater {
+func NewTicketStatusUpdater(cache *cache.PgCache, logger *zap.Logger, manager *lifecycle.Manager) *TicketStatusUpdater {
 	return &TicketStatusUpdater{
 		BaseListener: NewBaseListener(cache),
 		logger:       logger,
+		manager:      manager,
 	}
 }
 
-func (u *TicketStatusUpdater) HandleMessage(ctx context.Context, message []byte) {
+func (u *TicketStatusUpdater) HandleMessage(_ context.Context, message []byte) {
 	var event model.TicketStatusUpdate
 	if err := json.Unmarshal(message, &event); err != nil {
 		u.logger.Error("Failed to unmarshal event", zap.Error(err))
 		return
 	}
 
+	// The RPC client runs every listener on a single goroutine so that gateway events stay in order, so moving the
+	// channel must not block it. The context passed to HandleMessage is cancelled as soon as it returns.
+	u.manager.Go(func() {
+		ctx, cancel := u.BuildContext()
+		defer cancel()
+
+		u.moveTicket(ctx, event)
+	})
+}
+
+func (u *TicketStatusUpdater) moveTicket(ctx context.Context, event model.TicketStatusUpdate) {
 	worker, err := u.ContextForGuild(ctx, event.GuildId)
 	if err != nil {
 		u.logger.Error("Failed to get worker context", zap.Error(err))
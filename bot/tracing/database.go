@@ -0,0 +1,48 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"time"
+)
+
+// RecordQuery creates a span for a database operation once it has completed, from the data that pgx logs about it.
+// pgx v4 has no tracing hooks, so the span is backdated using the duration of the operation. Operations that aren't
+// part of a trace, such as those made by background tasks, are not recorded.
+func RecordQuery(ctx context.Context, system, operation string, data map[string]interface{}) {
+	duration, ok := data["time"].(time.Duration)
+	if !ok || !trace.SpanFromContext(ctx).IsRecording() {
+		return
+	}
+
+	end := time.Now()
+
+	attrs := []attribute.KeyValue{
+		attribute.String("db.system", system),
+		attribute.String("db.operation", operation),
+	}
+
+	// Arguments are deliberately not recorded, as they contain user data
+	if sql, ok := data["sql"].(string); ok {
+		attrs = append(attrs, attribute.String("db.statement", sql))
+	}
+
+	if table, ok := data["tableName"]; ok {
+		attrs = append(attrs, attribute.String("db.sql.table", fmt.Sprint(table)))
+	}
+
+	_, span := otel.Tracer(instrumentationName).Start(ctx, "db "+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(end.Add(-duration)),
+		trace.WithAttributes(attrs...),
+	)
+
+	if err, ok := data["err"].(error); ok {
+		RecordError(span, err)
+	}
+
+	span.End(trace.WithTimestamp(end))
+}
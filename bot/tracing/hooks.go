@@ -0,0 +1,37 @@
+package tracing
+
+import (
+	"fmt"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+)
+
+// PreRequestHook propagates the trace context to the REST proxy, so that its spans are joined to the worker's
+func PreRequestHook(_ string, req *http.Request) {
+	otel.GetTextMapPropagator().Inject(req.Context(), propagation.HeaderCarrier(req.Header))
+}
+
+// PostRequestHook records the result of a Discord API request on the span that made it
+func PostRequestHook(res *http.Response, _ []byte) {
+	if res == nil || res.Request == nil {
+		return
+	}
+
+	span := trace.SpanFromContext(res.Request.Context())
+	if !span.IsRecording() {
+		return
+	}
+
+	span.SetAttributes(
+		attribute.String("http.request.method", res.Request.Method),
+		attribute.Int("http.response.status_code", res.StatusCode),
+	)
+
+	if res.StatusCode >= 400 {
+		span.SetStatus(codes.Error, fmt.Sprintf("discord returned status %d", res.StatusCode))
+	}
+}
@@ -0,0 +1,99 @@
+package tracing
+
+import (
+	"context"
+	"github.com/TicketsBot/worker/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+	"sync/atomic"
+)
+
+const instrumentationName = "github.com/TicketsBot/worker"
+
+var enabled atomic.Bool
+
+// Init configures the global tracer provider to export spans over OTLP/HTTP to the configured collector. If no
+// collector is configured, spans are not recorded and the returned function does nothing. Sentry tracing is configured
+// separately and is unaffected by this.
+func Init(ctx context.Context) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	if config.Conf.Tracing.Endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	opts := []otlptracehttp.Option{otlptracehttp.WithEndpointURL(config.Conf.Tracing.Endpoint)}
+	if config.Conf.Tracing.Insecure {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(config.Conf.Tracing.ServiceName),
+		attribute.String("worker.mode", string(config.Conf.WorkerMode)),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		// Respect the sampling decision made upstream, e.g. by the HTTP proxy
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.Conf.Tracing.SampleRate))),
+	)
+
+	otel.SetTracerProvider(provider)
+	enabled.Store(true)
+
+	return provider.Shutdown, nil
+}
+
+// Enabled returns whether spans are being exported
+func Enabled() bool {
+	return enabled.Load()
+}
+
+// Start starts a span as a child of any span in ctx
+func Start(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// StartServer starts a span for an inbound request, continuing the trace from the W3C trace context headers sent by
+// the caller, if there are any
+func StartServer(ctx context.Context, header http.Header, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(header))
+	return otel.Tracer(instrumentationName).Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attrs...),
+	)
+}
+
+// Detach returns a context that carries the span in ctx, but is not cancelled when ctx is. This is used when work
+// outlives the request that started it, such as commands that continue after the initial interaction response.
+func Detach(ctx context.Context) context.Context {
+	return trace.ContextWithSpan(context.Background(), trace.SpanFromContext(ctx))
+}
+
+// RecordError marks the span as failed
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
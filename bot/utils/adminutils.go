@@ -5,7 +5,7 @@ import (
 )
 
 func IsBotAdmin(id uint64) bool {
-	for _, admin := range config.Conf.Bot.Admins {
+	for _, admin := range config.Live().Admins {
 		if admin == id {
 			return true
 		}
@@ -19,7 +19,7 @@ func IsBotHelper(id uint64) bool {
 		return true
 	}
 
-	for _, helper := range config.Conf.Bot.Helpers {
+	for _, helper := range config.Live().Helpers {
 		if helper == id {
 			return true
 		}
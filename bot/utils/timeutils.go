@@ -1,7 +1,11 @@
 package utils
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -24,3 +28,80 @@ func FormatNullableTime(duration *time.Duration) string {
 		return FormatTime(*duration)
 	}
 }
+
+var durationUnits = map[byte]time.Duration{
+	's': time.Second,
+	'm': time.Minute,
+	'h': time.Hour,
+	'd': time.Hour * 24,
+	'w': time.Hour * 24 * 7,
+}
+
+// ParseDuration parses a user-supplied duration made up of one or more numbers followed by a unit, such as "2h" or
+// "1d 12h". Units are s, m, h, d and w.
+func ParseDuration(s string) (time.Duration, error) {
+	s = strings.ToLower(strings.ReplaceAll(s, " ", ""))
+	if s == "" {
+		return 0, errors.New("empty duration")
+	}
+
+	var total time.Duration
+	for len(s) > 0 {
+		i := 0
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+
+		if i == 0 || i == len(s) {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+
+		amount, err := strconv.Atoi(s[:i])
+		if err != nil {
+			return 0, err
+		}
+
+		unit, ok := durationUnits[s[i]]
+		if !ok {
+			return 0, fmt.Errorf("unknown unit %q", s[i])
+		}
+
+		// Guard against overflow
+		if amount > int(math.MaxInt64/unit) || total > math.MaxInt64-time.Duration(amount)*unit {
+			return 0, errors.New("duration is too long")
+		}
+
+		total += time.Duration(amount) * unit
+		s = s[i+1:]
+	}
+
+	return total, nil
+}
+
+var durationFormatUnits = []struct {
+	unit   time.Duration
+	suffix string
+}{
+	{time.Hour * 24, "d"},
+	{time.Hour, "h"},
+	{time.Minute, "m"},
+	{time.Second, "s"},
+}
+
+// FormatDuration formats a duration in the same form that ParseDuration accepts, such as "1d 12h". Anything less than a
+// second is dropped.
+func FormatDuration(d time.Duration) string {
+	var parts []string
+	for _, unit := range durationFormatUnits {
+		if d >= unit.unit {
+			parts = append(parts, fmt.Sprintf("%d%s", d/unit.unit, unit.suffix))
+			d %= unit.unit
+		}
+	}
+
+	if len(parts) == 0 {
+		return "0s"
+	}
+
+	return strings.Join(parts, " ")
+}
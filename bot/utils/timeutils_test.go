@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	cases := map[string]time.Duration{
+		"30m":      time.Minute * 30,
+		"2h":       time.Hour * 2,
+		"1d 12h":   time.Hour * 36,
+		"1W":       time.Hour * 24 * 7,
+		"1h30m15s": time.Hour + time.Minute*30 + time.Second*15,
+	}
+
+	for input, expected := range cases {
+		actual, err := ParseDuration(input)
+		require.NoError(t, err, input)
+		require.Equal(t, expected, actual, input)
+	}
+}
+
+func TestParseDurationInvalid(t *testing.T) {
+	for _, input := range []string{"", "h", "10", "5y", "-5m", "99999999999999999999d"} {
+		_, err := ParseDuration(input)
+		require.Error(t, err, input)
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	cases := map[time.Duration]string{
+		0:                                 "0s",
+		time.Second * 30:                  "30s",
+		time.Minute*90 + time.Second*5:    "1h 30m 5s",
+		time.Hour * 36:                    "1d 12h",
+		time.Hour*24*8 + time.Millisecond: "8d",
+	}
+
+	for input, expected := range cases {
+		require.Equal(t, expected, FormatDuration(input), input)
+
+		if input > 0 {
+			parsed, err := ParseDuration(expected)
+			require.NoError(t, err)
+			require.Equal(t, input.Truncate(time.Second), parsed)
+		}
+	}
+}
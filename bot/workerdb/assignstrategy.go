@@ -0,0 +1,43 @@
+package workerdb
+
+import "strings"
+
+// AssignStrategy is how a staff member is chosen to claim new tickets opened from a panel
+type AssignStrategy int16
+
+const (
+	// AssignRoundRobin cycles through the panel's support team in turn
+	AssignRoundRobin AssignStrategy = iota + 1
+	// AssignLeastLoaded picks the member of the panel's support team with the fewest open claimed tickets
+	AssignLeastLoaded
+	// AssignOnCall cycles through the members of the panel's support team who are currently on-call
+	AssignOnCall
+)
+
+var AssignStrategies = []AssignStrategy{AssignRoundRobin, AssignLeastLoaded, AssignOnCall}
+
+func (s AssignStrategy) String() string {
+	switch s {
+	case AssignRoundRobin:
+		return "round_robin"
+	case AssignLeastLoaded:
+		return "least_loaded"
+	case AssignOnCall:
+		return "on_call"
+	default:
+		return "unknown"
+	}
+}
+
+// ParseAssignStrategy parses a strategy from its name, ignoring case and surrounding whitespace
+func ParseAssignStrategy(s string) (AssignStrategy, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+
+	for _, strategy := range AssignStrategies {
+		if strategy.String() == s {
+			return strategy, true
+		}
+	}
+
+	return 0, false
+}
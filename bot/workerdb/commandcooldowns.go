@@ -0,0 +1,99 @@
+package workerdb
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"time"
+)
+
+// CommandCooldown replaces the default cooldown of a command in a guild. Zero uses disables the cooldown.
+type CommandCooldown struct {
+	GuildId uint64
+	// CommandName is the full name of the command including any subcommands, e.g. "stats user"
+	CommandName string
+	Uses        int
+	Window      time.Duration
+}
+
+type CommandCooldownTable struct {
+	*pgxpool.Pool
+}
+
+func newCommandCooldownTable(db *pgxpool.Pool) *CommandCooldownTable {
+	return &CommandCooldownTable{
+		db,
+	}
+}
+
+func (c *CommandCooldownTable) Get(ctx context.Context, guildId uint64, commandName string) (cooldown CommandCooldown, ok bool, e error) {
+	query := `
+SELECT "guild_id", "command_name", "uses", "window_seconds"
+FROM command_cooldowns
+WHERE "guild_id" = $1 AND "command_name" = $2;`
+
+	var windowSeconds int
+	err := c.QueryRow(ctx, query, guildId, commandName).Scan(&cooldown.GuildId, &cooldown.CommandName, &cooldown.Uses, &windowSeconds)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return CommandCooldown{}, false, nil
+		}
+
+		return CommandCooldown{}, false, err
+	}
+
+	cooldown.Window = time.Duration(windowSeconds) * time.Second
+	return cooldown, true, nil
+}
+
+func (c *CommandCooldownTable) GetAll(ctx context.Context, guildId uint64) ([]CommandCooldown, error) {
+	query := `
+SELECT "guild_id", "command_name", "uses", "window_seconds"
+FROM command_cooldowns
+WHERE "guild_id" = $1;`
+
+	rows, err := c.Query(ctx, query, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var cooldowns []CommandCooldown
+	for rows.Next() {
+		var cooldown CommandCooldown
+		var windowSeconds int
+		if err := rows.Scan(&cooldown.GuildId, &cooldown.CommandName, &cooldown.Uses, &windowSeconds); err != nil {
+			return nil, err
+		}
+
+		cooldown.Window = time.Duration(windowSeconds) * time.Second
+		cooldowns = append(cooldowns, cooldown)
+	}
+
+	return cooldowns, rows.Err()
+}
+
+func (c *CommandCooldownTable) Set(ctx context.Context, cooldown CommandCooldown) (err error) {
+	query := `
+INSERT INTO command_cooldowns("guild_id", "command_name", "uses", "window_seconds")
+VALUES($1, $2, $3, $4)
+ON CONFLICT("guild_id", "command_name") DO UPDATE SET
+	"uses" = $3,
+	"window_seconds" = $4;`
+
+	_, err = c.Exec(ctx, query, cooldown.GuildId, cooldown.CommandName, cooldown.Uses, int(cooldown.Window/time.Second))
+	return
+}
+
+// Delete returns whether the command's cooldown had been overridden
+func (c *CommandCooldownTable) Delete(ctx context.Context, guildId uint64, commandName string) (bool, error) {
+	query := `DELETE FROM command_cooldowns WHERE "guild_id" = $1 AND "command_name" = $2;`
+
+	tag, err := c.Exec(ctx, query, guildId, commandName)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
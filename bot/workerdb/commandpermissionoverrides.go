@@ -0,0 +1,105 @@
+package workerdb
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// CommandPermissionOverride allows or denies a role or user the use of a command in a guild, regardless of the
+// permission level the command requires
+type CommandPermissionOverride struct {
+	GuildId uint64
+	// CommandName is the full name of the command including any subcommands, e.g. "claim self"
+	CommandName string
+	TargetId    uint64
+	IsRole      bool
+	Allow       bool
+}
+
+type CommandPermissionOverrideTable struct {
+	*pgxpool.Pool
+}
+
+func newCommandPermissionOverrideTable(db *pgxpool.Pool) *CommandPermissionOverrideTable {
+	return &CommandPermissionOverrideTable{
+		db,
+	}
+}
+
+func (o *CommandPermissionOverrideTable) GetByCommand(ctx context.Context, guildId uint64, commandName string) ([]CommandPermissionOverride, error) {
+	query := `
+SELECT "guild_id", "command_name", "target_id", "is_role", "allow"
+FROM command_permission_overrides
+WHERE "guild_id" = $1 AND "command_name" = $2
+ORDER BY "is_role" DESC, "target_id" ASC;`
+
+	return o.query(ctx, query, guildId, commandName)
+}
+
+func (o *CommandPermissionOverrideTable) GetAll(ctx context.Context, guildId uint64) ([]CommandPermissionOverride, error) {
+	query := `
+SELECT "guild_id", "command_name", "target_id", "is_role", "allow"
+FROM command_permission_overrides
+WHERE "guild_id" = $1;`
+
+	return o.query(ctx, query, guildId)
+}
+
+func (o *CommandPermissionOverrideTable) query(ctx context.Context, query string, args ...interface{}) ([]CommandPermissionOverride, error) {
+	rows, err := o.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var overrides []CommandPermissionOverride
+	for rows.Next() {
+		var override CommandPermissionOverride
+		if err := rows.Scan(
+			&override.GuildId, &override.CommandName, &override.TargetId, &override.IsRole, &override.Allow,
+		); err != nil {
+			return nil, err
+		}
+
+		overrides = append(overrides, override)
+	}
+
+	return overrides, rows.Err()
+}
+
+func (o *CommandPermissionOverrideTable) Set(ctx context.Context, override CommandPermissionOverride) (err error) {
+	query := `
+INSERT INTO command_permission_overrides("guild_id", "command_name", "target_id", "is_role", "allow")
+VALUES($1, $2, $3, $4, $5)
+ON CONFLICT("guild_id", "command_name", "target_id") DO UPDATE SET
+	"is_role" = $4,
+	"allow" = $5;`
+
+	_, err = o.Exec(ctx, query, override.GuildId, override.CommandName, override.TargetId, override.IsRole, override.Allow)
+	return
+}
+
+// Delete returns whether the role or user had an override for the command
+func (o *CommandPermissionOverrideTable) Delete(ctx context.Context, guildId uint64, commandName string, targetId uint64) (bool, error) {
+	query := `DELETE FROM command_permission_overrides WHERE "guild_id" = $1 AND "command_name" = $2 AND "target_id" = $3;`
+
+	tag, err := o.Exec(ctx, query, guildId, commandName, targetId)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() > 0, nil
+}
+
+// DeleteAll returns the number of overrides that were removed from the command
+func (o *CommandPermissionOverrideTable) DeleteAll(ctx context.Context, guildId uint64, commandName string) (int64, error) {
+	query := `DELETE FROM command_permission_overrides WHERE "guild_id" = $1 AND "command_name" = $2;`
+
+	tag, err := o.Exec(ctx, query, guildId, commandName)
+	if err != nil {
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,45 @@
+package workerdb
+
+import "github.com/jackc/pgx/v4/pgxpool"
+
+// Database holds the tables owned by the worker itself, rather than by the shared database module. They live in the
+// same Postgres database, so may reference the shared tables. They are created and changed by the migrations in
+// migrations.go, which are applied by cmd/migrate rather than by the worker.
+type Database struct {
+	pool *pgxpool.Pool
+
+	CommandCooldowns           *CommandCooldownTable
+	CommandPermissionOverrides *CommandPermissionOverrideTable
+	PanelAutoAssign            *PanelAutoAssignTable
+	PanelPriority              *PanelPriorityTable
+	PanelSla                   *PanelSlaTable
+	PriorityCategories         *PriorityCategoryTable
+	SlaBreaches                *SlaBreachTable
+	SnoozeCategory             *SnoozeCategoryTable
+	TicketCoClaimers           *TicketCoClaimerTable
+	TicketMerges               *TicketMergeTable
+	TicketPriority             *TicketPriorityTable
+	TicketReminders            *TicketReminderTable
+	TicketSnoozes              *TicketSnoozeTable
+	TicketTemporaryAccess      *TicketTemporaryAccessTable
+}
+
+func NewDatabase(pool *pgxpool.Pool) *Database {
+	return &Database{
+		pool:                       pool,
+		CommandCooldowns:           newCommandCooldownTable(pool),
+		CommandPermissionOverrides: newCommandPermissionOverrideTable(pool),
+		PanelAutoAssign:            newPanelAutoAssignTable(pool),
+		PanelPriority:              newPanelPriorityTable(pool),
+		PanelSla:                   newPanelSlaTable(pool),
+		PriorityCategories:         newPriorityCategoryTable(pool),
+		SlaBreaches:                newSlaBreachTable(pool),
+		SnoozeCategory:             newSnoozeCategoryTable(pool),
+		TicketCoClaimers:           newTicketCoClaimerTable(pool),
+		TicketMerges:               newTicketMergeTable(pool),
+		TicketPriority:             newTicketPriorityTable(pool),
+		TicketReminders:            newTicketReminderTable(pool),
+		TicketSnoozes:              newTicketSnoozeTable(pool),
+		TicketTemporaryAccess:      newTicketTemporaryAccessTable(pool),
+	}
+}
@@ -0,0 +1,89 @@
+package workerdb
+
+import (
+	"context"
+	"fmt"
+)
+
+// Migration is a change to the worker's tables. Migrations are applied in order of version by cmd/migrate, and each is
+// only ever applied once.
+type Migration struct {
+	Version int
+	Name    string
+	Sql     string
+}
+
+// Arbitrary key for the advisory lock held while migrating, so that two migrations cannot run at once
+const migrationLockId = 7_263_104_415
+
+const migrationsSchema = `
+CREATE TABLE IF NOT EXISTS worker_schema_migrations(
+	"version" int4 NOT NULL,
+	"name" varchar(255) NOT NULL,
+	"applied_at" timestamptz NOT NULL DEFAULT NOW(),
+	PRIMARY KEY("version")
+);
+`
+
+// Migrate applies any migrations that have not been applied yet in a single transaction, returning those it applied.
+// The tables owned by the shared database module must have been created first, as the worker's tables reference them.
+func (d *Database) Migrate(ctx context.Context) ([]Migration, error) {
+	tx, err := d.pool.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock($1);`, migrationLockId); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, migrationsSchema); err != nil {
+		return nil, err
+	}
+
+	rows, err := tx.Query(ctx, `SELECT "version" FROM worker_schema_migrations;`)
+	if err != nil {
+		return nil, err
+	}
+
+	appliedVersions := make(map[int]bool)
+	for rows.Next() {
+		var version int
+		if err := rows.Scan(&version); err != nil {
+			rows.Close()
+			return nil, err
+		}
+
+		appliedVersions[version] = true
+	}
+
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var applied []Migration
+	for _, migration := range migrations {
+		if appliedVersions[migration.Version] {
+			continue
+		}
+
+		if _, err := tx.Exec(ctx, migration.Sql); err != nil {
+			return nil, fmt.Errorf("failed to apply migration %d (%s): %w", migration.Version, migration.Name, err)
+		}
+
+		if _, err := tx.Exec(ctx, `INSERT INTO worker_schema_migrations("version", "name") VALUES($1, $2);`, migration.Version, migration.Name); err != nil {
+			return nil, err
+		}
+
+		applied = append(applied, migration)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	return applied, nil
+}
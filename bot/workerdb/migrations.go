@@ -0,0 +1,187 @@
+package workerdb
+
+// migrations must only ever be appended to, with increasing versions. Databases that have already applied a migration
+// will not apply it again, so changes to existing tables need a new migration.
+var migrations = []Migration{
+	{
+		Version: 1,
+		Name:    "ticket priorities",
+		Sql: `
+CREATE TABLE IF NOT EXISTS ticket_priority(
+	"guild_id" int8 NOT NULL,
+	"ticket_id" int4 NOT NULL,
+	"priority" int2 NOT NULL,
+	FOREIGN KEY("guild_id", "ticket_id") REFERENCES tickets("guild_id", "id"),
+	PRIMARY KEY("guild_id", "ticket_id")
+);
+
+CREATE TABLE IF NOT EXISTS priority_categories(
+	"guild_id" int8 NOT NULL,
+	"priority" int2 NOT NULL,
+	"category_id" int8 NOT NULL,
+	PRIMARY KEY("guild_id", "priority")
+);
+
+CREATE TABLE IF NOT EXISTS panel_priority(
+	"panel_id" int4 NOT NULL,
+	"default_priority" int2,
+	"form_input_id" int4,
+	FOREIGN KEY("panel_id") REFERENCES panels("panel_id") ON DELETE CASCADE,
+	FOREIGN KEY("form_input_id") REFERENCES form_input("id") ON DELETE SET NULL,
+	PRIMARY KEY("panel_id")
+);
+`,
+	},
+	{
+		Version: 2,
+		Name:    "sla timers",
+		Sql: `
+CREATE TABLE IF NOT EXISTS panel_sla(
+	"panel_id" int4 NOT NULL,
+	"first_response" interval,
+	"resolution" interval,
+	"escalation_role_id" int8,
+	FOREIGN KEY("panel_id") REFERENCES panels("panel_id") ON DELETE CASCADE,
+	PRIMARY KEY("panel_id")
+);
+
+CREATE TABLE IF NOT EXISTS sla_breaches(
+	"guild_id" int8 NOT NULL,
+	"ticket_id" int4 NOT NULL,
+	"type" int2 NOT NULL,
+	"breached_at" timestamptz NOT NULL,
+	FOREIGN KEY("guild_id", "ticket_id") REFERENCES tickets("guild_id", "id"),
+	PRIMARY KEY("guild_id", "ticket_id", "type")
+);
+CREATE INDEX IF NOT EXISTS sla_breaches_guild_id ON sla_breaches("guild_id", "breached_at");
+`,
+	},
+	{
+		Version: 3,
+		Name:    "ticket merges",
+		Sql: `
+CREATE TABLE IF NOT EXISTS ticket_merges(
+	"guild_id" int8 NOT NULL,
+	"source_ticket_id" int4 NOT NULL,
+	"target_ticket_id" int4 NOT NULL,
+	"merged_at" timestamptz NOT NULL,
+	FOREIGN KEY("guild_id", "source_ticket_id") REFERENCES tickets("guild_id", "id"),
+	FOREIGN KEY("guild_id", "target_ticket_id") REFERENCES tickets("guild_id", "id"),
+	PRIMARY KEY("guild_id", "source_ticket_id")
+);
+CREATE INDEX IF NOT EXISTS ticket_merges_target ON ticket_merges("guild_id", "target_ticket_id");
+`,
+	},
+	{
+		Version: 4,
+		Name:    "ticket snoozes",
+		Sql: `
+CREATE TABLE IF NOT EXISTS ticket_snoozes(
+	"guild_id" int8 NOT NULL,
+	"ticket_id" int4 NOT NULL,
+	"snoozed_until" timestamptz NOT NULL,
+	"snoozed_by" int8 NOT NULL,
+	"reason" text,
+	"previous_parent_id" int8,
+	FOREIGN KEY("guild_id", "ticket_id") REFERENCES tickets("guild_id", "id"),
+	PRIMARY KEY("guild_id", "ticket_id")
+);
+
+CREATE TABLE IF NOT EXISTS snooze_category(
+	"guild_id" int8 NOT NULL,
+	"category_id" int8 NOT NULL,
+	PRIMARY KEY("guild_id")
+);
+`,
+	},
+	{
+		Version: 5,
+		Name:    "panel auto assign",
+		Sql: `
+CREATE TABLE IF NOT EXISTS panel_auto_assign(
+	"panel_id" int4 NOT NULL,
+	"strategy" int2 NOT NULL,
+	FOREIGN KEY("panel_id") REFERENCES panels("panel_id") ON DELETE CASCADE,
+	PRIMARY KEY("panel_id")
+);
+`,
+	},
+	{
+		Version: 6,
+		Name:    "ticket co-claimers",
+		Sql: `
+CREATE TABLE IF NOT EXISTS ticket_co_claimers(
+	"guild_id" int8 NOT NULL,
+	"ticket_id" int4 NOT NULL,
+	"user_id" int8 NOT NULL,
+	FOREIGN KEY("guild_id", "ticket_id") REFERENCES tickets("guild_id", "id"),
+	PRIMARY KEY("guild_id", "ticket_id", "user_id")
+);
+`,
+	},
+	{
+		Version: 7,
+		Name:    "ticket reminders",
+		Sql: `
+CREATE TABLE IF NOT EXISTS ticket_reminders(
+	"id" SERIAL NOT NULL,
+	"guild_id" int8 NOT NULL,
+	"ticket_id" int4 NOT NULL,
+	"created_by" int8 NOT NULL,
+	"user_id" int8,
+	"message" text NOT NULL,
+	"remind_at" timestamptz NOT NULL,
+	FOREIGN KEY("guild_id", "ticket_id") REFERENCES tickets("guild_id", "id"),
+	PRIMARY KEY("id")
+);
+CREATE INDEX IF NOT EXISTS ticket_reminders_ticket ON ticket_reminders("guild_id", "ticket_id");
+`,
+	},
+	{
+		Version: 8,
+		Name:    "ticket temporary access",
+		Sql: `
+CREATE TABLE IF NOT EXISTS ticket_temporary_access(
+	"guild_id" int8 NOT NULL,
+	"ticket_id" int4 NOT NULL,
+	"target_id" int8 NOT NULL,
+	"is_role" bool NOT NULL,
+	"added_by" int8 NOT NULL,
+	"expires_at" timestamptz NOT NULL,
+	"previous_allow" int8,
+	"previous_deny" int8,
+	"was_member" bool NOT NULL DEFAULT 'f',
+	"added_user_ids" int8[],
+	FOREIGN KEY("guild_id", "ticket_id") REFERENCES tickets("guild_id", "id"),
+	PRIMARY KEY("guild_id", "ticket_id", "target_id")
+);
+`,
+	},
+	{
+		Version: 9,
+		Name:    "command permission overrides",
+		Sql: `
+CREATE TABLE IF NOT EXISTS command_permission_overrides(
+	"guild_id" int8 NOT NULL,
+	"command_name" varchar(100) NOT NULL,
+	"target_id" int8 NOT NULL,
+	"is_role" bool NOT NULL,
+	"allow" bool NOT NULL,
+	PRIMARY KEY("guild_id", "command_name", "target_id")
+);
+`,
+	},
+	{
+		Version: 10,
+		Name:    "command cooldowns",
+		Sql: `
+CREATE TABLE IF NOT EXISTS command_cooldowns(
+	"guild_id" int8 NOT NULL,
+	"command_name" varchar(100) NOT NULL,
+	"uses" int4 NOT NULL,
+	"window_seconds" int4 NOT NULL,
+	PRIMARY KEY("guild_id", "command_name")
+);
+`,
+	},
+}
@@ -0,0 +1,14 @@
+package workerdb
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestMigrationVersionsIncrease(t *testing.T) {
+	for i, migration := range migrations {
+		require.Equal(t, i+1, migration.Version, "migration %q has the wrong version", migration.Name)
+		require.NotEmpty(t, migration.Name)
+		require.NotEmpty(t, migration.Sql)
+	}
+}
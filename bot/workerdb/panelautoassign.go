@@ -0,0 +1,78 @@
+package workerdb
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type PanelAutoAssignTable struct {
+	*pgxpool.Pool
+}
+
+func newPanelAutoAssignTable(db *pgxpool.Pool) *PanelAutoAssignTable {
+	return &PanelAutoAssignTable{
+		db,
+	}
+}
+
+func (a *PanelAutoAssignTable) Get(ctx context.Context, panelId int) (strategy AssignStrategy, ok bool, e error) {
+	query := `SELECT "strategy" FROM panel_auto_assign WHERE "panel_id" = $1;`
+	if err := a.QueryRow(ctx, query, panelId).Scan(&strategy); err != nil {
+		if err == pgx.ErrNoRows {
+			return 0, false, nil
+		}
+
+		return 0, false, err
+	}
+
+	return strategy, true, nil
+}
+
+func (a *PanelAutoAssignTable) Set(ctx context.Context, panelId int, strategy AssignStrategy) (err error) {
+	query := `
+INSERT INTO panel_auto_assign("panel_id", "strategy")
+VALUES($1, $2)
+ON CONFLICT("panel_id") DO UPDATE SET "strategy" = $2;`
+
+	_, err = a.Exec(ctx, query, panelId, strategy)
+	return
+}
+
+func (a *PanelAutoAssignTable) Delete(ctx context.Context, panelId int) (err error) {
+	query := `DELETE FROM panel_auto_assign WHERE "panel_id" = $1;`
+	_, err = a.Exec(ctx, query, panelId)
+	return
+}
+
+// GetOpenClaimCounts returns the number of open tickets each of the given users has claimed in the guild. Users
+// without any open claimed tickets are omitted.
+func (a *PanelAutoAssignTable) GetOpenClaimCounts(ctx context.Context, guildId uint64, userIds []uint64) (map[uint64]int, error) {
+	query := `
+SELECT ticket_claims.user_id, COUNT(*)
+FROM ticket_claims
+INNER JOIN tickets
+ON tickets.guild_id = ticket_claims.guild_id AND tickets.id = ticket_claims.ticket_id
+WHERE ticket_claims.guild_id = $1 AND ticket_claims.user_id = ANY($2) AND tickets.open = true
+GROUP BY ticket_claims.user_id;`
+
+	rows, err := a.Query(ctx, query, guildId, userIds)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	counts := make(map[uint64]int)
+	for rows.Next() {
+		var userId uint64
+		var count int
+		if err := rows.Scan(&userId, &count); err != nil {
+			return nil, err
+		}
+
+		counts[userId] = count
+	}
+
+	return counts, rows.Err()
+}
@@ -0,0 +1,55 @@
+package workerdb
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// PanelPriority is the priority given to tickets opened from a panel. If FormInputId is set and the answer to that
+// input is the name of a priority, the answer is used. Otherwise, DefaultPriority is used if set.
+type PanelPriority struct {
+	PanelId         int
+	DefaultPriority *Priority
+	FormInputId     *int
+}
+
+type PanelPriorityTable struct {
+	*pgxpool.Pool
+}
+
+func newPanelPriorityTable(db *pgxpool.Pool) *PanelPriorityTable {
+	return &PanelPriorityTable{
+		db,
+	}
+}
+
+func (p *PanelPriorityTable) Get(ctx context.Context, panelId int) (settings PanelPriority, ok bool, e error) {
+	query := `SELECT "panel_id", "default_priority", "form_input_id" FROM panel_priority WHERE "panel_id" = $1;`
+
+	if err := p.QueryRow(ctx, query, panelId).Scan(&settings.PanelId, &settings.DefaultPriority, &settings.FormInputId); err != nil {
+		if err == pgx.ErrNoRows {
+			return PanelPriority{}, false, nil
+		}
+
+		return PanelPriority{}, false, err
+	}
+
+	return settings, true, nil
+}
+
+func (p *PanelPriorityTable) Set(ctx context.Context, settings PanelPriority) (err error) {
+	query := `
+INSERT INTO panel_priority("panel_id", "default_priority", "form_input_id")
+VALUES($1, $2, $3)
+ON CONFLICT("panel_id") DO UPDATE SET "default_priority" = $2, "form_input_id" = $3;`
+
+	_, err = p.Exec(ctx, query, settings.PanelId, settings.DefaultPriority, settings.FormInputId)
+	return
+}
+
+func (p *PanelPriorityTable) Delete(ctx context.Context, panelId int) (err error) {
+	query := `DELETE FROM panel_priority WHERE "panel_id" = $1;`
+	_, err = p.Exec(ctx, query, panelId)
+	return
+}
@@ -0,0 +1,86 @@
+package workerdb
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"time"
+)
+
+type SlaType int16
+
+const (
+	SlaFirstResponse SlaType = iota + 1
+	SlaResolution
+)
+
+func (t SlaType) String() string {
+	switch t {
+	case SlaFirstResponse:
+		return "first_response"
+	case SlaResolution:
+		return "resolution"
+	default:
+		return "unknown"
+	}
+}
+
+// PanelSla holds the service level targets for tickets opened from a panel. A nil target is not tracked.
+type PanelSla struct {
+	PanelId          int
+	FirstResponse    *time.Duration
+	Resolution       *time.Duration
+	EscalationRoleId *uint64
+}
+
+// Target returns the target for the given SLA type
+func (s PanelSla) Target(slaType SlaType) *time.Duration {
+	switch slaType {
+	case SlaFirstResponse:
+		return s.FirstResponse
+	case SlaResolution:
+		return s.Resolution
+	default:
+		return nil
+	}
+}
+
+type PanelSlaTable struct {
+	*pgxpool.Pool
+}
+
+func newPanelSlaTable(db *pgxpool.Pool) *PanelSlaTable {
+	return &PanelSlaTable{
+		db,
+	}
+}
+
+func (p *PanelSlaTable) Get(ctx context.Context, panelId int) (sla PanelSla, ok bool, e error) {
+	query := `SELECT "panel_id", "first_response", "resolution", "escalation_role_id" FROM panel_sla WHERE "panel_id" = $1;`
+
+	if err := p.QueryRow(ctx, query, panelId).Scan(&sla.PanelId, &sla.FirstResponse, &sla.Resolution, &sla.EscalationRoleId); err != nil {
+		if err == pgx.ErrNoRows {
+			return PanelSla{}, false, nil
+		}
+
+		return PanelSla{}, false, err
+	}
+
+	return sla, true, nil
+}
+
+func (p *PanelSlaTable) Set(ctx context.Context, sla PanelSla) (err error) {
+	query := `
+INSERT INTO panel_sla("panel_id", "first_response", "resolution", "escalation_role_id")
+VALUES($1, $2, $3, $4)
+ON CONFLICT("panel_id") DO UPDATE SET "first_response" = $2, "resolution" = $3, "escalation_role_id" = $4;`
+
+	_, err = p.Exec(ctx, query, sla.PanelId, sla.FirstResponse, sla.Resolution, sla.EscalationRoleId)
+	return
+}
+
+func (p *PanelSlaTable) Delete(ctx context.Context, panelId int) (err error) {
+	query := `DELETE FROM panel_sla WHERE "panel_id" = $1;`
+	_, err = p.Exec(ctx, query, panelId)
+	return
+}
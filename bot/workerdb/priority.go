@@ -0,0 +1,43 @@
+package workerdb
+
+import "strings"
+
+type Priority int16
+
+const (
+	PriorityLow Priority = iota + 1
+	PriorityNormal
+	PriorityHigh
+	PriorityUrgent
+)
+
+// Priorities are all priorities, from lowest to highest
+var Priorities = []Priority{PriorityLow, PriorityNormal, PriorityHigh, PriorityUrgent}
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityLow:
+		return "low"
+	case PriorityNormal:
+		return "normal"
+	case PriorityHigh:
+		return "high"
+	case PriorityUrgent:
+		return "urgent"
+	default:
+		return "unknown"
+	}
+}
+
+// ParsePriority parses a priority from its name, ignoring case and surrounding whitespace
+func ParsePriority(s string) (Priority, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+
+	for _, priority := range Priorities {
+		if priority.String() == s {
+			return priority, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,67 @@
+package workerdb
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// PriorityCategoryTable holds the category that ticket channels are moved into when they are given a priority
+type PriorityCategoryTable struct {
+	*pgxpool.Pool
+}
+
+func newPriorityCategoryTable(db *pgxpool.Pool) *PriorityCategoryTable {
+	return &PriorityCategoryTable{
+		db,
+	}
+}
+
+func (c *PriorityCategoryTable) Get(ctx context.Context, guildId uint64, priority Priority) (categoryId *uint64, e error) {
+	query := `SELECT "category_id" FROM priority_categories WHERE "guild_id" = $1 AND "priority" = $2;`
+	if err := c.QueryRow(ctx, query, guildId, priority).Scan(&categoryId); err != nil && err != pgx.ErrNoRows {
+		e = err
+	}
+
+	return
+}
+
+func (c *PriorityCategoryTable) GetAll(ctx context.Context, guildId uint64) (map[Priority]uint64, error) {
+	query := `SELECT "priority", "category_id" FROM priority_categories WHERE "guild_id" = $1;`
+
+	rows, err := c.Query(ctx, query, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	categories := make(map[Priority]uint64)
+	for rows.Next() {
+		var priority Priority
+		var categoryId uint64
+		if err := rows.Scan(&priority, &categoryId); err != nil {
+			return nil, err
+		}
+
+		categories[priority] = categoryId
+	}
+
+	return categories, rows.Err()
+}
+
+func (c *PriorityCategoryTable) Set(ctx context.Context, guildId uint64, priority Priority, categoryId uint64) (err error) {
+	query := `
+INSERT INTO priority_categories("guild_id", "priority", "category_id")
+VALUES($1, $2, $3)
+ON CONFLICT("guild_id", "priority") DO UPDATE SET "category_id" = $3;`
+
+	_, err = c.Exec(ctx, query, guildId, priority, categoryId)
+	return
+}
+
+func (c *PriorityCategoryTable) Delete(ctx context.Context, guildId uint64, priority Priority) (err error) {
+	query := `DELETE FROM priority_categories WHERE "guild_id" = $1 AND "priority" = $2;`
+	_, err = c.Exec(ctx, query, guildId, priority)
+	return
+}
@@ -0,0 +1,52 @@
+package workerdb
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"time"
+)
+
+// SlaBreachCounts are the number of SLA breaches in a guild over the same windows as the analytics statistics
+type SlaBreachCounts struct {
+	AllTime uint64
+	Monthly uint64
+	Weekly  uint64
+}
+
+type SlaBreachTable struct {
+	*pgxpool.Pool
+}
+
+func newSlaBreachTable(db *pgxpool.Pool) *SlaBreachTable {
+	return &SlaBreachTable{
+		db,
+	}
+}
+
+// Add records a breach, returning false if the breach had already been recorded
+func (s *SlaBreachTable) Add(ctx context.Context, guildId uint64, ticketId int, slaType SlaType, breachedAt time.Time) (bool, error) {
+	query := `
+INSERT INTO sla_breaches("guild_id", "ticket_id", "type", "breached_at")
+VALUES($1, $2, $3, $4)
+ON CONFLICT("guild_id", "ticket_id", "type") DO NOTHING;`
+
+	res, err := s.Exec(ctx, query, guildId, ticketId, slaType, breachedAt)
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, nil
+}
+
+func (s *SlaBreachTable) GetCounts(ctx context.Context, guildId uint64) (counts SlaBreachCounts, e error) {
+	query := `
+SELECT
+	COUNT(*),
+	COUNT(*) FILTER (WHERE "breached_at" > NOW() - INTERVAL '28 days'),
+	COUNT(*) FILTER (WHERE "breached_at" > NOW() - INTERVAL '7 days')
+FROM sla_breaches
+WHERE "guild_id" = $1;`
+
+	e = s.QueryRow(ctx, query, guildId).Scan(&counts.AllTime, &counts.Monthly, &counts.Weekly)
+	return
+}
@@ -0,0 +1,43 @@
+package workerdb
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+// SnoozeCategoryTable holds the category that ticket channels are moved into while they are snoozed
+type SnoozeCategoryTable struct {
+	*pgxpool.Pool
+}
+
+func newSnoozeCategoryTable(db *pgxpool.Pool) *SnoozeCategoryTable {
+	return &SnoozeCategoryTable{
+		db,
+	}
+}
+
+func (c *SnoozeCategoryTable) Get(ctx context.Context, guildId uint64) (categoryId *uint64, e error) {
+	query := `SELECT "category_id" FROM snooze_category WHERE "guild_id" = $1;`
+	if err := c.QueryRow(ctx, query, guildId).Scan(&categoryId); err != nil && err != pgx.ErrNoRows {
+		e = err
+	}
+
+	return
+}
+
+func (c *SnoozeCategoryTable) Set(ctx context.Context, guildId, categoryId uint64) (err error) {
+	query := `
+INSERT INTO snooze_category("guild_id", "category_id")
+VALUES($1, $2)
+ON CONFLICT("guild_id") DO UPDATE SET "category_id" = $2;`
+
+	_, err = c.Exec(ctx, query, guildId, categoryId)
+	return
+}
+
+func (c *SnoozeCategoryTable) Delete(ctx context.Context, guildId uint64) (err error) {
+	query := `DELETE FROM snooze_category WHERE "guild_id" = $1;`
+	_, err = c.Exec(ctx, query, guildId)
+	return
+}
@@ -0,0 +1,99 @@
+package workerdb
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"time"
+)
+
+// TicketCoClaimerTable holds the staff who have claimed a ticket alongside its claimer in ticket_claims
+type TicketCoClaimerTable struct {
+	*pgxpool.Pool
+}
+
+func newTicketCoClaimerTable(db *pgxpool.Pool) *TicketCoClaimerTable {
+	return &TicketCoClaimerTable{
+		db,
+	}
+}
+
+func (c *TicketCoClaimerTable) Get(ctx context.Context, guildId uint64, ticketId int) ([]uint64, error) {
+	query := `SELECT "user_id" FROM ticket_co_claimers WHERE "guild_id" = $1 AND "ticket_id" = $2 ORDER BY "user_id";`
+
+	rows, err := c.Query(ctx, query, guildId, ticketId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var users []uint64
+	for rows.Next() {
+		var userId uint64
+		if err := rows.Scan(&userId); err != nil {
+			return nil, err
+		}
+
+		users = append(users, userId)
+	}
+
+	return users, rows.Err()
+}
+
+func (c *TicketCoClaimerTable) IsCoClaimer(ctx context.Context, guildId uint64, ticketId int, userId uint64) (isCoClaimer bool, e error) {
+	query := `SELECT EXISTS(SELECT 1 FROM ticket_co_claimers WHERE "guild_id" = $1 AND "ticket_id" = $2 AND "user_id" = $3);`
+	if err := c.QueryRow(ctx, query, guildId, ticketId, userId).Scan(&isCoClaimer); err != nil && err != pgx.ErrNoRows {
+		e = err
+	}
+
+	return
+}
+
+func (c *TicketCoClaimerTable) Add(ctx context.Context, guildId uint64, ticketId int, userId uint64) (err error) {
+	query := `
+INSERT INTO ticket_co_claimers("guild_id", "ticket_id", "user_id")
+VALUES($1, $2, $3)
+ON CONFLICT("guild_id", "ticket_id", "user_id") DO NOTHING;`
+
+	_, err = c.Exec(ctx, query, guildId, ticketId, userId)
+	return
+}
+
+func (c *TicketCoClaimerTable) Remove(ctx context.Context, guildId uint64, ticketId int, userId uint64) (err error) {
+	query := `DELETE FROM ticket_co_claimers WHERE "guild_id" = $1 AND "ticket_id" = $2 AND "user_id" = $3;`
+	_, err = c.Exec(ctx, query, guildId, ticketId, userId)
+	return
+}
+
+func (c *TicketCoClaimerTable) DeleteAll(ctx context.Context, guildId uint64, ticketId int) (err error) {
+	query := `DELETE FROM ticket_co_claimers WHERE "guild_id" = $1 AND "ticket_id" = $2;`
+	_, err = c.Exec(ctx, query, guildId, ticketId)
+	return
+}
+
+// stats
+
+func (c *TicketCoClaimerTable) GetCoClaimedSinceCount(ctx context.Context, guildId, userId uint64, interval time.Duration) (count int, e error) {
+	query := `
+SELECT COUNT(*)
+FROM ticket_co_claimers
+INNER JOIN tickets
+ON ticket_co_claimers.guild_id = tickets.guild_id AND ticket_co_claimers.ticket_id = tickets.id
+WHERE ticket_co_claimers.guild_id = $1 AND ticket_co_claimers.user_id = $2 AND tickets.open_time > NOW() - $3::interval;`
+
+	if err := c.QueryRow(ctx, query, guildId, userId, interval).Scan(&count); err != nil && err != pgx.ErrNoRows {
+		e = err
+	}
+
+	return
+}
+
+func (c *TicketCoClaimerTable) GetCoClaimedCount(ctx context.Context, guildId, userId uint64) (count int, e error) {
+	query := `SELECT COUNT(*) FROM ticket_co_claimers WHERE "guild_id" = $1 AND "user_id" = $2;`
+	if err := c.QueryRow(ctx, query, guildId, userId).Scan(&count); err != nil && err != pgx.ErrNoRows {
+		e = err
+	}
+
+	return
+}
@@ -0,0 +1,63 @@
+package workerdb
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"time"
+)
+
+// TicketMergeTable records tickets that have been closed by merging them into another ticket
+type TicketMergeTable struct {
+	*pgxpool.Pool
+}
+
+func newTicketMergeTable(db *pgxpool.Pool) *TicketMergeTable {
+	return &TicketMergeTable{
+		db,
+	}
+}
+
+func (m *TicketMergeTable) Add(ctx context.Context, guildId uint64, sourceTicketId, targetTicketId int) (err error) {
+	query := `
+INSERT INTO ticket_merges("guild_id", "source_ticket_id", "target_ticket_id", "merged_at")
+VALUES($1, $2, $3, $4)
+ON CONFLICT("guild_id", "source_ticket_id") DO UPDATE SET "target_ticket_id" = $3, "merged_at" = $4;`
+
+	_, err = m.Exec(ctx, query, guildId, sourceTicketId, targetTicketId, time.Now())
+	return
+}
+
+// GetMergedInto returns the ID of the ticket that the ticket was merged into, if it has been merged
+func (m *TicketMergeTable) GetMergedInto(ctx context.Context, guildId uint64, ticketId int) (targetTicketId *int, e error) {
+	query := `SELECT "target_ticket_id" FROM ticket_merges WHERE "guild_id" = $1 AND "source_ticket_id" = $2;`
+	if err := m.QueryRow(ctx, query, guildId, ticketId).Scan(&targetTicketId); err != nil && err != pgx.ErrNoRows {
+		e = err
+	}
+
+	return
+}
+
+// GetMergedFrom returns the IDs of the tickets that have been merged into the ticket, oldest merge first
+func (m *TicketMergeTable) GetMergedFrom(ctx context.Context, guildId uint64, ticketId int) ([]int, error) {
+	query := `SELECT "source_ticket_id" FROM ticket_merges WHERE "guild_id" = $1 AND "target_ticket_id" = $2 ORDER BY "merged_at" ASC;`
+
+	rows, err := m.Query(ctx, query, guildId, ticketId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var ticketIds []int
+	for rows.Next() {
+		var ticketId int
+		if err := rows.Scan(&ticketId); err != nil {
+			return nil, err
+		}
+
+		ticketIds = append(ticketIds, ticketId)
+	}
+
+	return ticketIds, rows.Err()
+}
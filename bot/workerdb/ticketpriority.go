@@ -0,0 +1,43 @@
+package workerdb
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+)
+
+type TicketPriorityTable struct {
+	*pgxpool.Pool
+}
+
+func newTicketPriorityTable(db *pgxpool.Pool) *TicketPriorityTable {
+	return &TicketPriorityTable{
+		db,
+	}
+}
+
+// Get returns the priority of the ticket, which is PriorityNormal if it has not been set
+func (t *TicketPriorityTable) Get(ctx context.Context, guildId uint64, ticketId int) (Priority, error) {
+	query := `SELECT "priority" FROM ticket_priority WHERE "guild_id" = $1 AND "ticket_id" = $2;`
+
+	var priority Priority
+	if err := t.QueryRow(ctx, query, guildId, ticketId).Scan(&priority); err != nil {
+		if err == pgx.ErrNoRows {
+			return PriorityNormal, nil
+		}
+
+		return 0, err
+	}
+
+	return priority, nil
+}
+
+func (t *TicketPriorityTable) Set(ctx context.Context, guildId uint64, ticketId int, priority Priority) (err error) {
+	query := `
+INSERT INTO ticket_priority("guild_id", "ticket_id", "priority")
+VALUES($1, $2, $3)
+ON CONFLICT("guild_id", "ticket_id") DO UPDATE SET "priority" = $3;`
+
+	_, err = t.Exec(ctx, query, guildId, ticketId, priority)
+	return
+}
@@ -0,0 +1,135 @@
+package workerdb
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"time"
+)
+
+type TicketReminder struct {
+	Id        int
+	GuildId   uint64
+	TicketId  int
+	CreatedBy uint64
+	// UserId is the user to ping when the reminder fires. If nil, the user who created the reminder is pinged.
+	UserId   *uint64
+	Message  string
+	RemindAt time.Time
+}
+
+type TicketReminderTable struct {
+	*pgxpool.Pool
+}
+
+func newTicketReminderTable(db *pgxpool.Pool) *TicketReminderTable {
+	return &TicketReminderTable{
+		db,
+	}
+}
+
+func (r *TicketReminderTable) Get(ctx context.Context, id int) (reminder TicketReminder, ok bool, e error) {
+	query := `
+SELECT "id", "guild_id", "ticket_id", "created_by", "user_id", "message", "remind_at"
+FROM ticket_reminders
+WHERE "id" = $1;`
+
+	err := r.QueryRow(ctx, query, id).Scan(
+		&reminder.Id, &reminder.GuildId, &reminder.TicketId, &reminder.CreatedBy, &reminder.UserId, &reminder.Message, &reminder.RemindAt,
+	)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return TicketReminder{}, false, nil
+		}
+
+		return TicketReminder{}, false, err
+	}
+
+	return reminder, true, nil
+}
+
+// GetByTicket returns the pending reminders for the ticket, soonest first
+func (r *TicketReminderTable) GetByTicket(ctx context.Context, guildId uint64, ticketId int) ([]TicketReminder, error) {
+	query := `
+SELECT "id", "guild_id", "ticket_id", "created_by", "user_id", "message", "remind_at"
+FROM ticket_reminders
+WHERE "guild_id" = $1 AND "ticket_id" = $2
+ORDER BY "remind_at" ASC, "id" ASC;`
+
+	rows, err := r.Query(ctx, query, guildId, ticketId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var reminders []TicketReminder
+	for rows.Next() {
+		var reminder TicketReminder
+		if err := rows.Scan(
+			&reminder.Id, &reminder.GuildId, &reminder.TicketId, &reminder.CreatedBy, &reminder.UserId, &reminder.Message, &reminder.RemindAt,
+		); err != nil {
+			return nil, err
+		}
+
+		reminders = append(reminders, reminder)
+	}
+
+	return reminders, rows.Err()
+}
+
+func (r *TicketReminderTable) GetCount(ctx context.Context, guildId uint64, ticketId int) (count int, e error) {
+	query := `SELECT COUNT(*) FROM ticket_reminders WHERE "guild_id" = $1 AND "ticket_id" = $2;`
+	if err := r.QueryRow(ctx, query, guildId, ticketId).Scan(&count); err != nil && err != pgx.ErrNoRows {
+		e = err
+	}
+
+	return
+}
+
+func (r *TicketReminderTable) Create(ctx context.Context, reminder TicketReminder) (id int, err error) {
+	query := `
+INSERT INTO ticket_reminders("guild_id", "ticket_id", "created_by", "user_id", "message", "remind_at")
+VALUES($1, $2, $3, $4, $5, $6)
+RETURNING "id";`
+
+	err = r.QueryRow(ctx, query, reminder.GuildId, reminder.TicketId, reminder.CreatedBy, reminder.UserId, reminder.Message, reminder.RemindAt).Scan(&id)
+	return
+}
+
+// Delete returns whether the reminder existed, so that only one caller acts on it
+func (r *TicketReminderTable) Delete(ctx context.Context, id int) (bool, error) {
+	query := `DELETE FROM ticket_reminders WHERE "id" = $1;`
+
+	res, err := r.Exec(ctx, query, id)
+	if err != nil {
+		return false, err
+	}
+
+	return res.RowsAffected() > 0, nil
+}
+
+// DeleteByTicket deletes all pending reminders for the ticket, returning the IDs of those deleted
+func (r *TicketReminderTable) DeleteByTicket(ctx context.Context, guildId uint64, ticketId int) ([]int, error) {
+	query := `DELETE FROM ticket_reminders WHERE "guild_id" = $1 AND "ticket_id" = $2 RETURNING "id";`
+
+	rows, err := r.Query(ctx, query, guildId, ticketId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
@@ -0,0 +1,79 @@
+package workerdb
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"time"
+)
+
+type TicketSnooze struct {
+	GuildId      uint64
+	TicketId     int
+	SnoozedUntil time.Time
+	SnoozedBy    uint64
+	Reason       *string
+	// PreviousParentId is the category the channel was in before it was moved to the snooze category, if it was moved
+	PreviousParentId *uint64
+}
+
+type TicketSnoozeTable struct {
+	*pgxpool.Pool
+}
+
+func newTicketSnoozeTable(db *pgxpool.Pool) *TicketSnoozeTable {
+	return &TicketSnoozeTable{
+		db,
+	}
+}
+
+func (s *TicketSnoozeTable) Get(ctx context.Context, guildId uint64, ticketId int) (snooze TicketSnooze, ok bool, e error) {
+	query := `
+SELECT "guild_id", "ticket_id", "snoozed_until", "snoozed_by", "reason", "previous_parent_id"
+FROM ticket_snoozes
+WHERE "guild_id" = $1 AND "ticket_id" = $2;`
+
+	err := s.QueryRow(ctx, query, guildId, ticketId).Scan(
+		&snooze.GuildId, &snooze.TicketId, &snooze.SnoozedUntil, &snooze.SnoozedBy, &snooze.Reason, &snooze.PreviousParentId,
+	)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return TicketSnooze{}, false, nil
+		}
+
+		return TicketSnooze{}, false, err
+	}
+
+	return snooze, true, nil
+}
+
+// IsSnoozed returns whether the ticket is snoozed and the snooze has not yet expired
+func (s *TicketSnoozeTable) IsSnoozed(ctx context.Context, guildId uint64, ticketId int) (snoozed bool, e error) {
+	query := `SELECT EXISTS(SELECT 1 FROM ticket_snoozes WHERE "guild_id" = $1 AND "ticket_id" = $2 AND "snoozed_until" > NOW());`
+	if err := s.QueryRow(ctx, query, guildId, ticketId).Scan(&snoozed); err != nil && err != pgx.ErrNoRows {
+		e = err
+	}
+
+	return
+}
+
+func (s *TicketSnoozeTable) Set(ctx context.Context, snooze TicketSnooze) (err error) {
+	query := `
+INSERT INTO ticket_snoozes("guild_id", "ticket_id", "snoozed_until", "snoozed_by", "reason", "previous_parent_id")
+VALUES($1, $2, $3, $4, $5, $6)
+ON CONFLICT("guild_id", "ticket_id") DO UPDATE SET
+	"snoozed_until" = $3,
+	"snoozed_by" = $4,
+	"reason" = $5,
+	"previous_parent_id" = $6;`
+
+	_, err = s.Exec(ctx, query, snooze.GuildId, snooze.TicketId, snooze.SnoozedUntil, snooze.SnoozedBy, snooze.Reason, snooze.PreviousParentId)
+	return
+}
+
+func (s *TicketSnoozeTable) Delete(ctx context.Context, guildId uint64, ticketId int) (err error) {
+	query := `DELETE FROM ticket_snoozes WHERE "guild_id" = $1 AND "ticket_id" = $2;`
+	_, err = s.Exec(ctx, query, guildId, ticketId)
+	return
+}
@@ -0,0 +1,87 @@
+package workerdb
+
+import (
+	"context"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"time"
+)
+
+// TicketTemporaryAccess is a user or role that was added to a ticket with /add for a limited time
+type TicketTemporaryAccess struct {
+	GuildId   uint64
+	TicketId  int
+	TargetId  uint64
+	IsRole    bool
+	AddedBy   uint64
+	ExpiresAt time.Time
+
+	// PreviousAllow and PreviousDeny are the overwrite the target had on the ticket channel before it was added, which
+	// is restored when the access expires. They are nil if it had none, in which case the overwrite is deleted.
+	PreviousAllow *uint64
+	PreviousDeny  *uint64
+	// WasMember is whether the user was already a member of the ticket, in which case they are not removed from it
+	WasMember bool
+	// AddedUserIds are the members that were added to a thread ticket when the role was added. Only these are removed
+	// when the access expires.
+	AddedUserIds []uint64
+}
+
+type TicketTemporaryAccessTable struct {
+	*pgxpool.Pool
+}
+
+func newTicketTemporaryAccessTable(db *pgxpool.Pool) *TicketTemporaryAccessTable {
+	return &TicketTemporaryAccessTable{
+		db,
+	}
+}
+
+func (a *TicketTemporaryAccessTable) Get(ctx context.Context, guildId uint64, ticketId int, targetId uint64) (access TicketTemporaryAccess, ok bool, e error) {
+	query := `
+SELECT "guild_id", "ticket_id", "target_id", "is_role", "added_by", "expires_at", "previous_allow", "previous_deny", "was_member", "added_user_ids"
+FROM ticket_temporary_access
+WHERE "guild_id" = $1 AND "ticket_id" = $2 AND "target_id" = $3;`
+
+	err := a.QueryRow(ctx, query, guildId, ticketId, targetId).Scan(
+		&access.GuildId, &access.TicketId, &access.TargetId, &access.IsRole, &access.AddedBy, &access.ExpiresAt,
+		&access.PreviousAllow, &access.PreviousDeny, &access.WasMember, &access.AddedUserIds,
+	)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return TicketTemporaryAccess{}, false, nil
+		}
+
+		return TicketTemporaryAccess{}, false, err
+	}
+
+	return access, true, nil
+}
+
+func (a *TicketTemporaryAccessTable) Set(ctx context.Context, access TicketTemporaryAccess) (err error) {
+	query := `
+INSERT INTO ticket_temporary_access("guild_id", "ticket_id", "target_id", "is_role", "added_by", "expires_at", "previous_allow", "previous_deny", "was_member", "added_user_ids")
+VALUES($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+ON CONFLICT("guild_id", "ticket_id", "target_id") DO UPDATE SET
+	"is_role" = $4,
+	"added_by" = $5,
+	"expires_at" = $6,
+	"previous_allow" = $7,
+	"previous_deny" = $8,
+	"was_member" = $9,
+	"added_user_ids" = $10;`
+
+	_, err = a.Exec(
+		ctx, query,
+		access.GuildId, access.TicketId, access.TargetId, access.IsRole, access.AddedBy, access.ExpiresAt,
+		access.PreviousAllow, access.PreviousDeny, access.WasMember, access.AddedUserIds,
+	)
+	return
+}
+
+func (a *TicketTemporaryAccessTable) Delete(ctx context.Context, guildId uint64, ticketId int, targetId uint64) (err error) {
+	query := `DELETE FROM ticket_temporary_access WHERE "guild_id" = $1 AND "ticket_id" = $2 AND "target_id" = $3;`
+	_, err = a.Exec(ctx, query, guildId, ticketId, targetId)
+	return
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"github.com/TicketsBot/common/observability"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/config"
+	"go.uber.org/zap"
+	"time"
+)
+
+// Applies any migrations to the worker's own tables that have not been applied yet. This should be run before a new
+// version of the worker is deployed, after the shared database module's tables have been created.
+func main() {
+	config.Parse()
+
+	logger, err := observability.Configure(nil, config.Conf.JsonLogs, config.Conf.LogLevel)
+	if err != nil {
+		panic(err)
+	}
+
+	dbclient.Connect(logger.With(zap.String("service", "database")))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute*5)
+	defer cancel()
+
+	applied, err := dbclient.WorkerDb.Migrate(ctx)
+	if err != nil {
+		logger.Fatal("Failed to migrate worker tables", zap.Error(err))
+		return
+	}
+
+	if len(applied) == 0 {
+		logger.Info("Worker tables are already up to date")
+		return
+	}
+
+	for _, migration := range applied {
+		logger.Info("Applied migration", zap.Int("version", migration.Version), zap.String("name", migration.Name))
+	}
+}
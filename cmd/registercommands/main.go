@@ -9,6 +9,7 @@ import (
 	"github.com/TicketsBot/worker/i18n"
 	"github.com/rxdn/gdl/objects/interaction"
 	"github.com/rxdn/gdl/rest"
+	"sort"
 )
 
 var (
@@ -31,13 +32,21 @@ func main() {
 	commandManager := new(manager.CommandManager)
 	commandManager.RegisterCommands()
 
-	data, adminCommands := commandManager.BuildCreatePayload(false, AdminCommandGuildId)
+	data, adminCommands, skipped := commandManager.BuildCreatePayloadV2(false, AdminCommandGuildId)
+	if len(skipped) > 0 {
+		sort.Strings(skipped)
+
+		fmt.Printf("Skipping %d invalid translations:\n", len(skipped))
+		for _, message := range skipped {
+			fmt.Println(message)
+		}
+	}
 
 	var err error
 	if *GuildId == 0 {
-		must(rest.ModifyGlobalCommands(context.Background(), *Token, nil, *ApplicationId, data))
+		must(manager.ModifyGlobalCommands(context.Background(), *Token, nil, *ApplicationId, data))
 	} else {
-		must(rest.ModifyGuildCommands(context.Background(), *Token, nil, *ApplicationId, *GuildId, data))
+		must(manager.ModifyGuildCommands(context.Background(), *Token, nil, *ApplicationId, *GuildId, data))
 	}
 
 	if err != nil {
@@ -57,18 +66,18 @@ func main() {
 				}
 
 				if !found {
-					adminCommands = append(adminCommands, rest.CreateCommandData{
+					adminCommands = append(adminCommands, manager.CommandData{
 						Id:          cmd.Id,
 						Name:        cmd.Name,
 						Description: cmd.Description,
-						Options:     cmd.Options,
+						Options:     manager.NewCommandOptions(cmd.Options),
 						Type:        interaction.ApplicationCommandTypeChatInput,
 					})
 				}
 			}
 		}
 
-		must(rest.ModifyGuildCommands(context.Background(), *Token, nil, *ApplicationId, *AdminCommandGuildId, adminCommands))
+		must(manager.ModifyGuildCommands(context.Background(), *Token, nil, *ApplicationId, *AdminCommandGuildId, adminCommands))
 	}
 
 	cmds := must(rest.GetGlobalCommands(context.Background(), *Token, nil, *ApplicationId))
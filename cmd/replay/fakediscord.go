@@ -0,0 +1,89 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type RestCall struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	Query  string `json:"query,omitempty"`
+	Body   string `json:"body,omitempty"`
+}
+
+// fakeDiscord accepts every request, records it, and responds with an empty object or array so that the worker can
+// carry on processing without a real Discord API behind it.
+type fakeDiscord struct {
+	server *httptest.Server
+	calls  []RestCall
+	mu     sync.Mutex
+}
+
+// Endpoints whose last path segment is not a snowflake, but still return a single object
+var objectEndpoints = []string{"@me", "audit-logs", "preview", "widget", "vanity-url", "typing"}
+
+func newFakeDiscord() *fakeDiscord {
+	f := &fakeDiscord{}
+	f.server = httptest.NewServer(http.HandlerFunc(f.handle))
+	return f
+}
+
+func (f *fakeDiscord) handle(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	f.mu.Lock()
+	f.calls = append(f.calls, RestCall{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.RawQuery,
+		Body:   string(body),
+	})
+	f.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if r.Method == http.MethodDelete || r.Method == http.MethodPut {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if r.Method == http.MethodGet && isCollection(r.URL.Path) {
+		_, _ = w.Write([]byte("[]"))
+	} else {
+		_, _ = w.Write([]byte("{}"))
+	}
+}
+
+// TakeCalls returns the calls received since the last time TakeCalls was called
+func (f *fakeDiscord) TakeCalls() []RestCall {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := f.calls
+	f.calls = nil
+	return calls
+}
+
+func (f *fakeDiscord) Close() {
+	f.server.Close()
+}
+
+func isCollection(path string) bool {
+	segment := path[strings.LastIndex(path, "/")+1:]
+	if _, err := strconv.ParseUint(segment, 10, 64); err == nil {
+		return false
+	}
+
+	for _, endpoint := range objectEndpoints {
+		if segment == endpoint {
+			return false
+		}
+	}
+
+	return true
+}
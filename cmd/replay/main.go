@@ -0,0 +1,167 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"github.com/TicketsBot/archiverclient"
+	"github.com/TicketsBot/common/model"
+	"github.com/TicketsBot/common/observability"
+	"github.com/TicketsBot/common/premium"
+	"github.com/TicketsBot/worker/bot/cache"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/redis"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/config"
+	"github.com/TicketsBot/worker/event"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/gin-gonic/gin"
+	"github.com/rxdn/gdl/rest/request"
+	"go.uber.org/zap"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+)
+
+var (
+	RecordingPath = flag.String("file", "", "Recording to replay, as written by the worker when WORKER_RECORDING_PATH is set")
+	OutputPath    = flag.String("out", "", "File to write the report to, defaults to stdout")
+	Token         = flag.String("token", "replay-token", "Bot token to substitute for the redacted token in each record")
+	SettleTime    = flag.Duration("settle", time.Second*2, "Time to wait after each record for background work to finish")
+)
+
+// ReportEntry describes the REST calls that a single record produced. Reports from two versions of the worker can be
+// diffed to find changes in behaviour.
+type ReportEntry struct {
+	Index        int              `json:"index"`
+	Kind         event.RecordKind `json:"kind"`
+	ResponseCode int              `json:"response_code"`
+	Response     json.RawMessage  `json:"response,omitempty"`
+	Error        string           `json:"error,omitempty"`
+	Calls        []RestCall       `json:"calls"`
+}
+
+func main() {
+	flag.Parse()
+	if *RecordingPath == "" {
+		panic("no recording file")
+	}
+
+	config.Parse()
+
+	logger, err := observability.Configure(nil, config.Conf.JsonLogs, config.Conf.LogLevel)
+	if err != nil {
+		panic(err)
+	}
+
+	gin.SetMode(gin.ReleaseMode)
+
+	// Replay should only ever be pointed at local Postgres and Redis instances, as it will write to them
+	if err := redis.Connect(); err != nil {
+		logger.Fatal("Failed to connect to Redis", zap.Error(err))
+		return
+	}
+
+	dbclient.Connect(logger.With(zap.String("service", "database")))
+	i18n.Init()
+
+	pgCache, err := cache.Connect(logger.With(zap.String("service", "cache")))
+	if err != nil {
+		logger.Fatal("Failed to connect to cache", zap.Error(err))
+		return
+	}
+
+	cache.Client = &pgCache
+
+	mockPremium := premium.NewMockLookupClient(premium.Whitelabel, model.EntitlementSourcePatreon)
+	utils.PremiumClient = &mockPremium
+
+	fake := newFakeDiscord()
+	defer fake.Close()
+
+	fakeUrl, err := url.Parse(fake.server.URL)
+	if err != nil {
+		panic(err)
+	}
+
+	// Route every Discord request to the fake server, regardless of host
+	request.RegisterPreRequestHook(func(_ string, req *http.Request) {
+		req.URL.Scheme = fakeUrl.Scheme
+		req.URL.Host = fakeUrl.Host
+	})
+
+	// Transcripts are uploaded to the fake server too, so closes can run without an archiver
+	utils.ArchiverClient = archiverclient.NewArchiverClient(
+		archiverclient.NewProxyRetriever(fake.server.URL),
+		[]byte(config.Conf.Archiver.AesKey),
+	)
+
+	replayer := event.NewReplayer(logger.With(zap.String("service", "replay")), redis.Client, &pgCache, *Token)
+
+	in, err := os.Open(*RecordingPath)
+	if err != nil {
+		panic(err)
+	}
+
+	defer in.Close()
+
+	out := os.Stdout
+	if *OutputPath != "" {
+		out, err = os.Create(*OutputPath)
+		if err != nil {
+			panic(err)
+		}
+
+		defer out.Close()
+	}
+
+	encoder := json.NewEncoder(out)
+
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024) // Guild creates can be very large
+
+	var index int
+	for scanner.Scan() {
+		entry := ReportEntry{Index: index}
+		index++
+
+		var record event.Record
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			entry.Error = err.Error()
+			must(encoder.Encode(entry))
+			continue
+		}
+
+		entry.Kind = record.Kind
+
+		_ = fake.TakeCalls() // Discard anything left over from the previous record
+		code, body, err := replayer.Replay(record)
+		if err != nil {
+			entry.Error = err.Error()
+		} else {
+			entry.ResponseCode = code
+			if json.Valid(body) {
+				entry.Response = body
+			}
+		}
+
+		time.Sleep(*SettleTime)
+		entry.Calls = fake.TakeCalls()
+
+		must(encoder.Encode(entry))
+	}
+
+	if err := scanner.Err(); err != nil {
+		panic(err)
+	}
+
+	_, _ = fmt.Fprintf(os.Stderr, "Replayed %d records\n", index)
+}
+
+func must(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
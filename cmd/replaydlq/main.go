@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"github.com/TicketsBot/worker/config"
+	"github.com/TicketsBot/worker/event"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"time"
+)
+
+var (
+	DeadLetterTopic  = flag.String("dlq-topic", "", "Dead-letter topic to read from (defaults to KAFKA_DEAD_LETTER_TOPIC)")
+	EventsTopic      = flag.String("events-topic", "", "Topic to re-drive events to (defaults to KAFKA_EVENTS_TOPIC)")
+	ConsumerGroup    = flag.String("group", "worker-replaydlq", "Consumer group used to track which dead letters have been replayed")
+	Limit            = flag.Int("limit", 0, "Maximum number of events to replay, 0 for no limit")
+	IncludePermanent = flag.Bool("include-permanent", false, "Also replay events that failed with a non-retryable error")
+	Source           = flag.String("source", "", "Only replay events received from this source (kafka or http)")
+	IdleTimeout      = flag.Duration("idle-timeout", time.Second*10, "Stop once no dead letters have been received for this long")
+	DryRun           = flag.Bool("dry-run", false, "Print the events that would be replayed without producing or committing them")
+)
+
+func main() {
+	flag.Parse()
+	config.Parse()
+
+	if *DeadLetterTopic == "" {
+		*DeadLetterTopic = config.Conf.Kafka.DeadLetterTopic
+	}
+
+	if *EventsTopic == "" {
+		*EventsTopic = config.Conf.Kafka.EventsTopic
+	}
+
+	if *DeadLetterTopic == "" || *EventsTopic == "" {
+		panic("dead-letter topic and events topic must both be set")
+	}
+
+	client := must(kgo.NewClient(
+		kgo.SeedBrokers(config.Conf.Kafka.Brokers...),
+		kgo.ConsumerGroup(*ConsumerGroup),
+		kgo.ConsumeTopics(*DeadLetterTopic),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+		kgo.DisableAutoCommit(),
+	))
+	defer client.Close()
+
+	var replayed, skipped int
+	for *Limit == 0 || replayed < *Limit {
+		records, ok := poll(client)
+		if !ok {
+			break
+		}
+
+		var processed []*kgo.Record
+		for _, record := range records {
+			if *Limit != 0 && replayed >= *Limit {
+				break
+			}
+
+			processed = append(processed, record)
+
+			var deadLetter event.DeadLetter
+			if err := json.Unmarshal(record.Value, &deadLetter); err != nil {
+				fmt.Printf("Skipping malformed dead letter at offset %d: %v\n", record.Offset, err)
+				skipped++
+				continue
+			}
+
+			if !shouldReplay(deadLetter) {
+				skipped++
+				continue
+			}
+
+			fmt.Printf(
+				"Replaying event for bot %d (source: %s, failed at: %s, attempts: %d, reason: %s)\n",
+				deadLetter.Event.BotId, deadLetter.Source, deadLetter.FailedAt.Format(time.RFC3339), deadLetter.Attempts, deadLetter.Reason,
+			)
+
+			if !*DryRun {
+				value := must(json.Marshal(deadLetter.Event))
+				if err := client.ProduceSync(context.Background(), &kgo.Record{Topic: *EventsTopic, Value: value}).FirstErr(); err != nil {
+					panic(err)
+				}
+			}
+
+			replayed++
+		}
+
+		if !*DryRun {
+			if err := client.CommitRecords(context.Background(), processed...); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	fmt.Printf("Replayed %d events, skipped %d\n", replayed, skipped)
+}
+
+func poll(client *kgo.Client) ([]*kgo.Record, bool) {
+	ctx, cancel := context.WithTimeout(context.Background(), *IdleTimeout)
+	defer cancel()
+
+	fetches := client.PollFetches(ctx)
+	if fetches.IsClientClosed() {
+		return nil, false
+	}
+
+	if err := fetches.Err(); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, false
+		}
+
+		panic(err)
+	}
+
+	return fetches.Records(), true
+}
+
+func shouldReplay(deadLetter event.DeadLetter) bool {
+	if !deadLetter.Retryable && !*IncludePermanent {
+		return false
+	}
+
+	if *Source != "" && deadLetter.Source != *Source {
+		return false
+	}
+
+	return true
+}
+
+func must[T any](t T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+
+	return t
+}
@@ -2,10 +2,10 @@ package main
 
 import (
 	"cloud.google.com/go/profiler"
+	"context"
 	"fmt"
 	"github.com/TicketsBot/archiverclient"
 	"github.com/TicketsBot/common/model"
-	"github.com/TicketsBot/common/observability"
 	"github.com/TicketsBot/common/premium"
 	"github.com/TicketsBot/common/rpc"
 	"github.com/TicketsBot/common/sentry"
@@ -13,11 +13,13 @@ import (
 	"github.com/TicketsBot/worker/bot/cache"
 	"github.com/TicketsBot/worker/bot/dbclient"
 	"github.com/TicketsBot/worker/bot/integrations"
+	"github.com/TicketsBot/worker/bot/lifecycle"
 	"github.com/TicketsBot/worker/bot/listeners/messagequeue"
 	"github.com/TicketsBot/worker/bot/metrics/prometheus"
 	"github.com/TicketsBot/worker/bot/metrics/statsd"
 	"github.com/TicketsBot/worker/bot/redis"
 	"github.com/TicketsBot/worker/bot/rpc/listeners"
+	"github.com/TicketsBot/worker/bot/tracing"
 	"github.com/TicketsBot/worker/bot/utils"
 	"github.com/TicketsBot/worker/config"
 	"github.com/TicketsBot/worker/event"
@@ -33,12 +35,18 @@ import (
 	"time"
 )
 
+const consumerGroup = "worker"
+
 func main() {
 	go func() {
 		fmt.Println(http.ListenAndServe(":6060", nil))
 	}()
 
 	config.Parse()
+	if err := config.Conf.Validate(); err != nil {
+		fmt.Printf("Invalid config:\n%v\n", err)
+		os.Exit(1)
+	}
 
 	if config.Conf.CloudProfiler.Enabled {
 		cfg := profiler.Config{
@@ -52,20 +60,15 @@ func main() {
 		}
 	}
 
-	logger, err := observability.Configure(nil, config.Conf.JsonLogs, config.Conf.LogLevel)
+	logLevel := zap.NewAtomicLevelAt(config.Conf.LogLevel)
+	logger, err := configureLogger(logLevel)
 	if err != nil {
 		panic(err)
 	}
 
 	if len(config.Conf.DebugMode) == 0 {
 		logger.Info("Connecting to sentry")
-		if err := sentry.Initialise(sentry.Options{
-			Dsn:              config.Conf.Sentry.Dsn,
-			Debug:            config.Conf.DebugMode != "",
-			SampleRate:       config.Conf.Sentry.SampleRate,
-			EnableTracing:    config.Conf.Sentry.UseTracing,
-			TracesSampleRate: config.Conf.Sentry.TracingSampleRate,
-		}); err != nil {
+		if err := sentry.Initialise(sentryOptions(config.Live())); err != nil {
 			logger.Error("Failed to connect to sentry", zap.Error(err))
 		} else {
 			logger.Info(
@@ -77,6 +80,21 @@ func main() {
 		}
 	}
 
+	// Tracing must be configured before connecting to the database, as it changes what pgx logs
+	shutdownTracing, err := tracing.Init(context.Background())
+	if err != nil {
+		logger.Fatal("Failed to configure tracing", zap.Error(err))
+		return
+	}
+
+	if config.Conf.Tracing.Endpoint != "" {
+		logger.Info(
+			"Exporting traces",
+			zap.String("endpoint", config.Conf.Tracing.Endpoint),
+			zap.Float64("sample_rate", config.Conf.Tracing.SampleRate),
+		)
+	}
+
 	logger.Info("Connecting to Redis")
 	if err := redis.Connect(); err != nil {
 		logger.Fatal("Failed to connect to Redis", zap.Error(err))
@@ -147,41 +165,95 @@ func main() {
 	request.RegisterPreRequestHook(prometheus.PreRequestHook)
 	request.RegisterPostRequestHook(prometheus.PostRequestHook)
 
+	logger.Info("Registering tracing hooks")
+	request.RegisterPreRequestHook(tracing.PreRequestHook)
+	request.RegisterPostRequestHook(tracing.PostRequestHook)
+
 	logger.Info("Initialising integrations")
 	integrations.InitIntegrations()
 
-	go messagequeue.ListenTicketClose()
-	go messagequeue.ListenAutoClose()
-	go messagequeue.ListenCloseRequestTimer()
+	lifecycleManager := lifecycle.NewManager()
+
+	go messagequeue.ListenTicketClose(lifecycleManager)
+	go messagequeue.ListenAutoClose(lifecycleManager)
+	go messagequeue.ListenCloseRequestTimer(lifecycleManager)
+	go messagequeue.ListenSlaTimer(lifecycleManager)
+	go messagequeue.ListenSnoozeTimer(lifecycleManager)
+	go messagequeue.ListenReminderTimer(lifecycleManager)
+	go messagequeue.ListenAccessExpiryTimer(lifecycleManager)
+
+	go blacklist.StartCacheRefreshLoop(lifecycleManager.Context(), logger.With(zap.String("service", "blacklist_refresh")))
 
-	go blacklist.StartCacheRefreshLoop(logger.With(zap.String("service", "blacklist_refresh")))
+	var dlq *event.DeadLetterQueue
+	if config.Conf.Kafka.DeadLetterTopic != "" {
+		logger.Info("Connecting to dead-letter topic", zap.String("topic", config.Conf.Kafka.DeadLetterTopic))
+
+		dlq, err = event.NewDeadLetterQueue(
+			logger.With(zap.String("service", "dead-letter-queue")),
+			config.Conf.Kafka.Brokers,
+			config.Conf.Kafka.DeadLetterTopic,
+		)
+		if err != nil {
+			logger.Fatal("Failed to create dead-letter queue", zap.Error(err))
+			return
+		}
+
+		defer dlq.Close()
+	}
+
+	var recorder *event.Recorder
+	if config.Conf.Recording.Path != "" {
+		logger.Warn("Recording events and interactions", zap.String("path", config.Conf.Recording.Path))
+
+		recorder, err = event.NewRecorder(logger.With(zap.String("service", "recorder")), config.Conf.Recording.Path)
+		if err != nil {
+			logger.Fatal("Failed to open recording file", zap.Error(err))
+			return
+		}
+
+		defer recorder.Close()
+	}
+
+	var rpcClient *rpc.Client
+	var wg sync.WaitGroup
 
 	if config.Conf.WorkerMode == config.WorkerModeInteractions {
 		logger.Info("Starting HTTP server", zap.String("mode", string(config.Conf.WorkerMode)))
 
-		event.HttpListen(redis.Client, &pgCache)
+		go event.HttpListen(logger.With(zap.String("service", "http")), redis.Client, &pgCache, dlq, recorder, lifecycleManager, nil)
 	} else if config.Conf.WorkerMode == config.WorkerModeGateway {
 		logger.Info("Starting event listeners", zap.String("mode", string(config.Conf.WorkerMode)))
 
-		go event.HttpListen(redis.Client, &pgCache)
+		lagMonitor, err := event.NewKafkaLagMonitor(config.Conf.Kafka.Brokers, consumerGroup)
+		if err != nil {
+			logger.Fatal("Failed to create Kafka lag monitor", zap.Error(err))
+			return
+		}
+
+		defer lagMonitor.Close()
 
-		var wg sync.WaitGroup
+		go event.HttpListen(logger.With(zap.String("service", "http")), redis.Client, &pgCache, dlq, recorder, lifecycleManager, lagMonitor)
 
-		rpcClient, err := rpc.NewClient(
+		rpcClient, err = rpc.NewClient(
 			logger.With(zap.String("service", "rpc")),
 			rpc.Config{
-				Brokers:             config.Conf.Kafka.Brokers,
-				ConsumerGroup:       "worker",
-				ConsumerConcurrency: config.Conf.Kafka.GoroutineLimit,
+				Brokers:       config.Conf.Kafka.Brokers,
+				ConsumerGroup: consumerGroup,
+				// Events are passed to the listener's own executor, which needs to receive them in order. Every listener
+				// shares this goroutine, so none of them may block it.
+				ConsumerConcurrency: 1,
 			},
 			map[string]rpc.Listener{
 				// Listen for gateway events over Kafka
 				config.Conf.Kafka.EventsTopic: event.NewKafkaListener(
 					logger.With(zap.String("service", "gateway-events-kafka")),
 					&pgCache,
+					dlq,
+					recorder,
+					lifecycleManager,
 				),
 				// TODO: Don't hardcode
-				"tickets.rpc.categoryupdate": listeners.NewTicketStatusUpdater(&pgCache, logger),
+				"tickets.rpc.categoryupdate": listeners.NewTicketStatusUpdater(&pgCache, logger, lifecycleManager),
 			})
 
 		if err != nil {
@@ -194,21 +266,40 @@ func main() {
 			defer wg.Done()
 			rpcClient.StartConsumer()
 		}()
+	} else {
+		logger.Fatal("Invalid worker mode", zap.String("mode", string(config.Conf.WorkerMode)))
+	}
+
+	go handleReloads(logger.With(zap.String("service", "config")), logLevel)
+
+	shutdownCh := make(chan os.Signal, 1)
+	signal.Notify(shutdownCh, syscall.SIGINT, syscall.SIGTERM)
+	<-shutdownCh
 
-		shutdownCh := make(chan os.Signal, 1)
-		signal.Notify(shutdownCh, syscall.SIGINT, syscall.SIGTERM)
-		<-shutdownCh
+	logger.Info("Received shutdown signal", zap.Duration("timeout", config.Conf.ShutdownTimeout))
+	deadline := time.Now().Add(config.Conf.ShutdownTimeout)
 
-		logger.Info("Received shutdown signal")
+	// Stop fetching new events before draining, so that the drain isn't extended by new work
+	if rpcClient != nil {
 		rpcClient.Shutdown()
 
-		if waitTimeout(&wg, time.Second*10) {
-			logger.Info("Shutdown completed gracefully")
-		} else {
-			logger.Warn("Graceful shutdown timed out, exiting now")
+		if !waitTimeout(&wg, time.Until(deadline)) {
+			logger.Warn("Timed out waiting for Kafka consumer to stop")
 		}
+	}
+
+	if lifecycleManager.Shutdown(time.Until(deadline)) {
+		logger.Info("Shutdown completed gracefully")
 	} else {
-		logger.Fatal("Invalid worker mode", zap.String("mode", string(config.Conf.WorkerMode)))
+		logger.Warn("Graceful shutdown timed out, exiting now", zap.Int("in_flight", lifecycleManager.InFlight()))
+	}
+
+	// Flush any spans that are still buffered, even if the deadline has passed, as they cover the shutdown
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	if err := shutdownTracing(ctx); err != nil {
+		logger.Warn("Failed to flush traces", zap.Error(err))
 	}
 }
 
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"github.com/TicketsBot/common/observability"
+	"github.com/TicketsBot/common/sentry"
+	"github.com/TicketsBot/worker/config"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+// configureLogger builds the logger at debug level, and filters it with level instead, as the logger's own level
+// isn't exposed by the observability package and so can't be changed on reload
+func configureLogger(level zap.AtomicLevel) (*zap.Logger, error) {
+	logger, err := observability.Configure(nil, config.Conf.JsonLogs, zapcore.DebugLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	var wrapErr error
+	logger = logger.WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		filtered, err := zapcore.NewIncreaseLevelCore(core, level)
+		if err != nil {
+			wrapErr = err
+			return core
+		}
+
+		return filtered
+	}))
+
+	return logger, wrapErr
+}
+
+func sentryOptions(settings *config.LiveSettings) sentry.Options {
+	return sentry.Options{
+		Dsn:              config.Conf.Sentry.Dsn,
+		Debug:            config.Conf.DebugMode != "",
+		SampleRate:       settings.SentrySampleRate,
+		EnableTracing:    config.Conf.Sentry.UseTracing,
+		TracesSampleRate: settings.SentryTracingSampleRate,
+	}
+}
+
+// handleReloads reloads the live settings whenever the worker receives SIGHUP. Settings that are read through
+// config.Live(), such as the admin list and ticket rate limit, pick up the change by themselves; the log level and
+// Sentry client need to be updated here.
+func handleReloads(logger *zap.Logger, logLevel zap.AtomicLevel) {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, syscall.SIGHUP)
+
+	for range ch {
+		logger.Info("Received SIGHUP, reloading config")
+
+		settings, err := config.Reload()
+		if err != nil {
+			logger.Error("Failed to reload config, keeping current settings", zap.Error(err))
+			continue
+		}
+
+		logLevel.SetLevel(settings.LogLevel)
+
+		if len(config.Conf.DebugMode) == 0 {
+			if err := sentry.Initialise(sentryOptions(settings)); err != nil {
+				logger.Error("Failed to reinitialise sentry", zap.Error(err))
+			}
+		}
+
+		logger.Info(
+			"Reloaded config",
+			zap.Stringer("log_level", settings.LogLevel),
+			zap.Float64("sentry_sample_rate", settings.SentrySampleRate),
+			zap.Float64("sentry_tracing_sample_rate", settings.SentryTracingSampleRate),
+			zap.Int("ticket_rate_limit", settings.TicketRateLimit),
+			zap.Duration("ticket_rate_limit_interval", settings.TicketRateLimitInterval),
+			zap.Int("admins", len(settings.Admins)),
+			zap.Int("helpers", len(settings.Helpers)),
+		)
+	}
+}
@@ -16,11 +16,13 @@ type (
 		LogLevel    zapcore.Level `env:"WORKER_LOG_LEVEL" envDefault:"info"`
 		PremiumOnly bool          `env:"WORKER_PREMIUM_ONLY" envDefault:"false"`
 
-		WorkerMode WorkerMode `env:"WORKER_MODE"`
+		WorkerMode      WorkerMode    `env:"WORKER_MODE"`
+		ShutdownTimeout time.Duration `env:"WORKER_SHUTDOWN_TIMEOUT" envDefault:"25s"`
 
 		Discord struct {
 			Token            string        `env:"WORKER_PUBLIC_TOKEN"`
 			PublicBotId      uint64        `env:"WORKER_PUBLIC_ID"`
+			PublicKey        string        `env:"WORKER_PUBLIC_KEY"`
 			ProxyUrl         string        `env:"DISCORD_PROXY_URL"`
 			RequestTimeout   time.Duration `env:"DISCORD_REQUEST_TIMEOUT" envDefault:"15s"`
 			CallbackTimeout  time.Duration `env:"DISCORD_CALLBACK_TIMEOUT" envDefault:"2000ms"`
@@ -32,6 +34,9 @@ type (
 			SupportServerInvite string   `env:"SUPPORT_SERVER_INVITE"`
 			Admins              []uint64 `env:"WORKER_BOT_ADMINS"`
 			Helpers             []uint64 `env:"WORKER_BOT_HELPERS"`
+
+			TicketRateLimit         int           `env:"WORKER_TICKET_RATE_LIMIT" envDefault:"10"`
+			TicketRateLimitInterval time.Duration `env:"WORKER_TICKET_RATE_LIMIT_INTERVAL" envDefault:"30s"`
 		}
 
 		PremiumProxy struct {
@@ -86,11 +91,22 @@ type (
 		} `envPrefix:"WORKER_REDIS_"`
 
 		Kafka struct {
-			Brokers        []string `env:"BROKERS"`
-			EventsTopic    string   `env:"EVENTS_TOPIC"`
-			GoroutineLimit int      `env:"GOROUTINE_LIMIT" envDefault:"1000"`
+			Brokers         []string `env:"BROKERS"`
+			EventsTopic     string   `env:"EVENTS_TOPIC"`
+			DeadLetterTopic string   `env:"DEAD_LETTER_TOPIC"`
+			GoroutineLimit  int      `env:"GOROUTINE_LIMIT" envDefault:"1000"`
+
+			OrderedQueueSize  int      `env:"ORDERED_QUEUE_SIZE" envDefault:"64"`
+			MaxQueuedEvents   int      `env:"MAX_QUEUED_EVENTS" envDefault:"10000"`
+			OrderBypassEvents []string `env:"ORDER_BYPASS_EVENTS" envDefault:"PRESENCE_UPDATE,TYPING_START,MESSAGE_REACTION_ADD,MESSAGE_REACTION_REMOVE,MESSAGE_REACTION_REMOVE_ALL,MESSAGE_REACTION_REMOVE_EMOJI,INVITE_CREATE,INVITE_DELETE,ENTITLEMENT_CREATE,ENTITLEMENT_UPDATE,ENTITLEMENT_DELETE"`
 		} `envPrefix:"KAFKA_"`
 
+		EventRetry struct {
+			MaxAttempts    int           `env:"MAX_ATTEMPTS" envDefault:"3"`
+			InitialBackoff time.Duration `env:"INITIAL_BACKOFF" envDefault:"500ms"`
+			MaxBackoff     time.Duration `env:"MAX_BACKOFF" envDefault:"5s"`
+		} `envPrefix:"WORKER_EVENT_RETRY_"`
+
 		Prometheus struct {
 			Address string `env:"PROMETHEUS_SERVER_ADDR"`
 		}
@@ -107,6 +123,22 @@ type (
 			TracingSampleRate float64 `env:"TRACING_SAMPLE_RATE"`
 		} `envPrefix:"WORKER_SENTRY_"`
 
+		Tracing struct {
+			Endpoint    string  `env:"ENDPOINT"`
+			Insecure    bool    `env:"INSECURE" envDefault:"false"`
+			SampleRate  float64 `env:"SAMPLE_RATE" envDefault:"1.0"`
+			ServiceName string  `env:"SERVICE_NAME" envDefault:"worker"`
+		} `envPrefix:"WORKER_OTEL_"`
+
+		Recording struct {
+			Path string `env:"PATH"`
+		} `envPrefix:"WORKER_RECORDING_"`
+
+		Health struct {
+			CheckTimeout  time.Duration `env:"CHECK_TIMEOUT" envDefault:"2s"`
+			StatusTimeout time.Duration `env:"STATUS_TIMEOUT" envDefault:"5s"`
+		} `envPrefix:"WORKER_HEALTH_"`
+
 		CloudProfiler struct {
 			Enabled   bool   `env:"ENABLED" envDefault:"false"`
 			ProjectId string `env:"PROJECT_ID"`
@@ -123,8 +155,28 @@ const (
 	WorkerModeInteractions WorkerMode = "INTERACTIONS"
 )
 
+// Parse reads the config from the environment, layered over the file named by WORKER_CONFIG_FILE if it is set. It
+// doesn't validate the config, as not every binary needs every field; the worker calls Validate itself.
 func Parse() {
-	if err := env.Parse(&Conf); err != nil {
+	conf, err := load()
+	if err != nil {
 		panic(err)
 	}
+
+	Conf = conf
+	live.Store(newLiveSettings(conf))
+}
+
+func load() (Config, error) {
+	environment, err := environment()
+	if err != nil {
+		return Config{}, err
+	}
+
+	var conf Config
+	if err := env.ParseWithOptions(&conf, env.Options{Environment: environment}); err != nil {
+		return Config{}, err
+	}
+
+	return conf, nil
 }
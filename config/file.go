@@ -0,0 +1,109 @@
+package config
+
+import (
+	"fmt"
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// fileEnvKey is the env var holding the path to the optional config file
+const fileEnvKey = "WORKER_CONFIG_FILE"
+
+// loadFile reads a YAML or TOML config file and flattens it into env var names, so that it can be layered under the
+// real environment. Keys are joined with underscores and upper-cased, so both of these set WORKER_REDIS_ADDR:
+//
+//	WORKER_REDIS_ADDR: localhost:6379
+//
+//	worker:
+//	  redis:
+//	    addr: localhost:6379
+//
+// Lists are joined with commas, matching the separator used for slices in the environment.
+func loadFile(path string) (map[string]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsed map[string]any
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &parsed)
+	case ".toml":
+		err = toml.Unmarshal(data, &parsed)
+	default:
+		return nil, fmt.Errorf("unsupported config file type %s, expected .yaml, .yml or .toml", filepath.Ext(path))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	values := make(map[string]string)
+	if err := flatten(values, "", parsed); err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %w", path, err)
+	}
+
+	return values, nil
+}
+
+func flatten(values map[string]string, prefix string, data map[string]any) error {
+	for key, value := range data {
+		name := strings.ToUpper(key)
+		if prefix != "" {
+			name = prefix + "_" + name
+		}
+
+		switch v := value.(type) {
+		case map[string]any:
+			if err := flatten(values, name, v); err != nil {
+				return err
+			}
+		case []any:
+			items := make([]string, len(v))
+			for i, item := range v {
+				if _, ok := item.(map[string]any); ok {
+					return fmt.Errorf("%s: lists may only contain scalar values", name)
+				}
+
+				items[i] = fmt.Sprint(item)
+			}
+
+			values[name] = strings.Join(items, ",")
+		case nil:
+			// Treat null as unset, so that defaults still apply
+		default:
+			values[name] = fmt.Sprint(v)
+		}
+	}
+
+	return nil
+}
+
+// environment returns the values that the config is parsed from: the config file, if there is one, overridden by the
+// process environment
+func environment() (map[string]string, error) {
+	values := make(map[string]string)
+
+	if path := os.Getenv(fileEnvKey); path != "" {
+		fileValues, err := loadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		for key, value := range fileValues {
+			values[key] = value
+		}
+	}
+
+	for _, entry := range os.Environ() {
+		if key, value, ok := strings.Cut(entry, "="); ok {
+			values[key] = value
+		}
+	}
+
+	return values, nil
+}
@@ -0,0 +1,57 @@
+package config
+
+import (
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadYamlFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "worker.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+WORKER_MODE: GATEWAY
+kafka:
+  brokers: [a:9092, b:9092]
+  goroutine_limit: 50
+worker:
+  bot:
+    admins: [1, 2]
+`), 0600))
+
+	values, err := loadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "GATEWAY", values["WORKER_MODE"])
+	require.Equal(t, "a:9092,b:9092", values["KAFKA_BROKERS"])
+	require.Equal(t, "50", values["KAFKA_GOROUTINE_LIMIT"])
+	require.Equal(t, "1,2", values["WORKER_BOT_ADMINS"])
+}
+
+func TestLoadTomlFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "worker.toml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+[worker.sentry]
+sample_rate = 0.5
+
+[kafka]
+events_topic = "events"
+`), 0600))
+
+	values, err := loadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "0.5", values["WORKER_SENTRY_SAMPLE_RATE"])
+	require.Equal(t, "events", values["KAFKA_EVENTS_TOPIC"])
+}
+
+func TestEnvironmentOverridesFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "worker.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("WORKER_MODE: GATEWAY\nHTTP_ADDR: :8080\n"), 0600))
+
+	t.Setenv(fileEnvKey, path)
+	t.Setenv("WORKER_MODE", "INTERACTIONS")
+
+	conf, err := load()
+	require.NoError(t, err)
+	require.Equal(t, WorkerModeInteractions, conf.WorkerMode)
+	require.Equal(t, ":8080", conf.Bot.HttpAddress)
+}
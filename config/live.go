@@ -0,0 +1,63 @@
+package config
+
+import (
+	"go.uber.org/zap/zapcore"
+	"sync/atomic"
+	"time"
+)
+
+// LiveSettings are the settings that can be changed without a restart, by sending the worker SIGHUP. Conf is never
+// modified after startup, so these must be read through Live() for changes to take effect.
+type LiveSettings struct {
+	LogLevel zapcore.Level
+
+	SentrySampleRate        float64
+	SentryTracingSampleRate float64
+
+	TicketRateLimit         int
+	TicketRateLimitInterval time.Duration
+
+	Admins  []uint64
+	Helpers []uint64
+}
+
+var live atomic.Pointer[LiveSettings]
+
+func newLiveSettings(conf Config) *LiveSettings {
+	return &LiveSettings{
+		LogLevel:                conf.LogLevel,
+		SentrySampleRate:        conf.Sentry.SampleRate,
+		SentryTracingSampleRate: conf.Sentry.TracingSampleRate,
+		TicketRateLimit:         conf.Bot.TicketRateLimit,
+		TicketRateLimitInterval: conf.Bot.TicketRateLimitInterval,
+		Admins:                  conf.Bot.Admins,
+		Helpers:                 conf.Bot.Helpers,
+	}
+}
+
+// Live returns the current live settings. The returned value must not be modified.
+func Live() *LiveSettings {
+	if settings := live.Load(); settings != nil {
+		return settings
+	}
+
+	return newLiveSettings(Conf)
+}
+
+// Reload re-reads the config file and environment, and replaces the live settings if the new config is valid. Any
+// other changes are ignored until the worker is restarted.
+func Reload() (*LiveSettings, error) {
+	conf, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := conf.Validate(); err != nil {
+		return nil, err
+	}
+
+	settings := newLiveSettings(conf)
+	live.Store(settings)
+
+	return settings, nil
+}
@@ -0,0 +1,95 @@
+package config
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Validate checks that every field required by the configured WorkerMode is set, and that values are in range, so
+// that a bad config is reported on startup rather than when the field is first used
+func (c *Config) Validate() error {
+	var errs []error
+
+	required := func(name string, set bool) {
+		if !set {
+			errs = append(errs, fmt.Errorf("%s is required", name))
+		}
+	}
+
+	positive := func(name string, value int) {
+		if value <= 0 {
+			errs = append(errs, fmt.Errorf("%s must be greater than 0, got %d", name, value))
+		}
+	}
+
+	rate := func(name string, value float64) {
+		if value < 0 || value > 1 {
+			errs = append(errs, fmt.Errorf("%s must be between 0 and 1, got %f", name, value))
+		}
+	}
+
+	switch c.WorkerMode {
+	case WorkerModeGateway:
+		required("KAFKA_BROKERS", len(c.Kafka.Brokers) > 0)
+		required("KAFKA_EVENTS_TOPIC", c.Kafka.EventsTopic != "")
+		positive("KAFKA_GOROUTINE_LIMIT", c.Kafka.GoroutineLimit)
+		positive("KAFKA_ORDERED_QUEUE_SIZE", c.Kafka.OrderedQueueSize)
+		positive("KAFKA_MAX_QUEUED_EVENTS", c.Kafka.MaxQueuedEvents)
+	case WorkerModeInteractions:
+	default:
+		errs = append(errs, fmt.Errorf("WORKER_MODE must be %s or %s, got %q", WorkerModeGateway, WorkerModeInteractions, c.WorkerMode))
+	}
+
+	// The dead-letter queue is produced to in both modes
+	if c.Kafka.DeadLetterTopic != "" && c.WorkerMode != WorkerModeGateway {
+		required("KAFKA_BROKERS", len(c.Kafka.Brokers) > 0)
+	}
+
+	required("WORKER_PUBLIC_TOKEN", c.Discord.Token != "")
+	required("WORKER_PUBLIC_ID", c.Discord.PublicBotId != 0)
+	required("HTTP_ADDR", c.Bot.HttpAddress != "")
+
+	if c.Discord.PublicKey != "" {
+		if decoded, err := hex.DecodeString(c.Discord.PublicKey); err != nil || len(decoded) != 32 {
+			errs = append(errs, errors.New("WORKER_PUBLIC_KEY must be a hex encoded Ed25519 public key"))
+		}
+	}
+
+	required("DATABASE_HOST", c.Database.Host != "")
+	required("DATABASE_NAME", c.Database.Database != "")
+	required("DATABASE_USER", c.Database.Username != "")
+	positive("DATABASE_THREADS", c.Database.Threads)
+
+	required("CACHE_HOST", c.Cache.Host != "")
+	required("CACHE_NAME", c.Cache.Database != "")
+	required("CACHE_USER", c.Cache.Username != "")
+	positive("CACHE_THREADS", c.Cache.Threads)
+
+	required("CLICKHOUSE_ADDR", c.Clickhouse.Address != "")
+	positive("CLICKHOUSE_THREADS", c.Clickhouse.Threads)
+
+	required("WORKER_REDIS_ADDR", c.Redis.Address != "")
+	positive("WORKER_REDIS_THREADS", c.Redis.Threads)
+
+	required("WORKER_ARCHIVER_URL", c.Archiver.Url != "")
+	required("WORKER_ARCHIVER_AES_KEY", c.Archiver.AesKey != "")
+
+	positive("WORKER_EVENT_RETRY_MAX_ATTEMPTS", c.EventRetry.MaxAttempts)
+	positive("WORKER_TICKET_RATE_LIMIT", c.Bot.TicketRateLimit)
+
+	if c.Bot.TicketRateLimitInterval < time.Second {
+		errs = append(errs, fmt.Errorf("WORKER_TICKET_RATE_LIMIT_INTERVAL must be at least 1s, got %s", c.Bot.TicketRateLimitInterval))
+	}
+
+	rate("WORKER_SENTRY_SAMPLE_RATE", c.Sentry.SampleRate)
+	rate("WORKER_SENTRY_TRACING_SAMPLE_RATE", c.Sentry.TracingSampleRate)
+	rate("WORKER_OTEL_SAMPLE_RATE", c.Tracing.SampleRate)
+
+	if c.CloudProfiler.Enabled {
+		required("WORKER_CLOUD_PROFILER_PROJECT_ID", c.CloudProfiler.ProjectId != "")
+	}
+
+	return errors.Join(errs...)
+}
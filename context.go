@@ -1,9 +1,13 @@
 package worker
 
 import (
+	"context"
+	"github.com/TicketsBot/worker/bot/tracing"
 	"github.com/rxdn/gdl/cache"
 	"github.com/rxdn/gdl/objects/user"
 	"github.com/rxdn/gdl/rest/ratelimit"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Context struct {
@@ -13,8 +17,30 @@ type Context struct {
 	ShardId      int
 	Cache        *cache.PgCache
 	RateLimiter  *ratelimit.Ratelimiter
+
+	// BaseContext carries the span that REST calls are traced under. It may be nil, and is never cancelled.
+	BaseContext context.Context
 }
 
 func (ctx *Context) Self() (user.User, error) {
 	return ctx.GetUser(ctx.BotId)
 }
+
+// WithContext returns a copy of the worker context, whose REST calls are traced as children of the span in c
+func (ctx *Context) WithContext(c context.Context) *Context {
+	copied := *ctx
+	copied.BaseContext = tracing.Detach(c)
+	return &copied
+}
+
+func (ctx *Context) startSpan(method string) (context.Context, trace.Span) {
+	base := ctx.BaseContext
+	if base == nil {
+		base = context.Background()
+	}
+
+	return tracing.Start(base, "discord."+method,
+		attribute.Int64("discord.bot_id", int64(ctx.BotId)),
+		attribute.Bool("discord.whitelabel", ctx.IsWhitelabel),
+	)
+}
@@ -324,6 +324,82 @@ func callCommand(
             arg0 = argValue
         }
 
+        v.Execute(ctx, arg0)
+    case settings.CooldownCommand:
+
+        v.Execute(ctx)
+    case settings.CooldownResetCommand:
+        var arg0 string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = argValue
+        }
+
+        v.Execute(ctx, arg0)
+    case settings.CooldownSetCommand:
+        var arg0 string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = argValue
+        }
+        var arg1 int
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt1.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt1.Name)
+            }
+
+            if err := cmd.Properties().Arguments[1].ValidateInteger(int(argValue)); err != nil {
+                return err
+            }
+            arg1 = int(argValue)
+        }
+        var arg2 *string
+
+        opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+        if !ok2 {
+            arg2 = nil
+        } else { 
+            argValue, ok := opt2.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt2.Name)
+            }
+            arg2 = &argValue
+        }
+
+        v.Execute(ctx, arg0, arg1, arg2)
+    case settings.CooldownViewCommand:
+        var arg0 string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = argValue
+        }
+
         v.Execute(ctx, arg0)
     case settings.LanguageCommand:
 
@@ -331,35 +407,360 @@ func callCommand(
     case settings.PanelCommand:
 
         v.Execute(ctx)
+    case settings.PermissionsAllowCommand:
+        var arg0 string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = argValue
+        }
+        var arg1 uint64
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            return ErrArgumentNotFound
+        } else {
+            raw, ok := opt1.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a snowflake", opt1.Name)
+            }
+
+            argValue, err := strconv.ParseUint(raw, 10, 64)
+            if err != nil {
+                return fmt.Errorf("option %s was not a valid snowflake", opt1.Name)
+            }
+            arg1 = argValue
+        }
+
+        v.Execute(ctx, arg0, arg1)
+    case settings.PermissionsCommand:
+
+        v.Execute(ctx)
+    case settings.PermissionsCommandGroup:
+
+        v.Execute(ctx)
+    case settings.PermissionsDenyCommand:
+        var arg0 string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = argValue
+        }
+        var arg1 uint64
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            return ErrArgumentNotFound
+        } else {
+            raw, ok := opt1.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a snowflake", opt1.Name)
+            }
+
+            argValue, err := strconv.ParseUint(raw, 10, 64)
+            if err != nil {
+                return fmt.Errorf("option %s was not a valid snowflake", opt1.Name)
+            }
+            arg1 = argValue
+        }
+
+        v.Execute(ctx, arg0, arg1)
+    case settings.PermissionsResetCommand:
+        var arg0 string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = argValue
+        }
+        var arg1 *uint64
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            arg1 = nil
+        } else {
+            raw, ok := opt1.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a snowflake", opt1.Name)
+            }
+
+            argValue, err := strconv.ParseUint(raw, 10, 64)
+            if err != nil {
+                return fmt.Errorf("option %s was not a valid snowflake", opt1.Name)
+            }
+            arg1 = &argValue
+        }
+
+        v.Execute(ctx, arg0, arg1)
+    case settings.PermissionsViewCommand:
+        var arg0 string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = argValue
+        }
+
+        v.Execute(ctx, arg0)
     case settings.PremiumCommand:
 
         v.Execute(ctx)
     case settings.RemoveAdminCommand:
         var arg0 uint64
 
-        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
-        if !ok0 {
-            return ErrArgumentNotFound
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else {
+            raw, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a snowflake", opt0.Name)
+            }
+
+            argValue, err := strconv.ParseUint(raw, 10, 64)
+            if err != nil {
+                return fmt.Errorf("option %s was not a valid snowflake", opt0.Name)
+            }
+            arg0 = argValue
+        }
+
+        v.Execute(ctx, arg0)
+    case settings.RemoveSupportCommand:
+        var arg0 uint64
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else {
+            raw, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a snowflake", opt0.Name)
+            }
+
+            argValue, err := strconv.ParseUint(raw, 10, 64)
+            if err != nil {
+                return fmt.Errorf("option %s was not a valid snowflake", opt0.Name)
+            }
+            arg0 = argValue
+        }
+
+        v.Execute(ctx, arg0)
+    case settings.ViewStaffCommand:
+
+        v.Execute(ctx)
+    case setup.AutoAssignSetupCommand:
+        var arg0 int
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt0.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt0.Name)
+            }
+            arg0 = int(argValue)
+        }
+        var arg1 *string
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            arg1 = nil
+        } else { 
+            argValue, ok := opt1.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt1.Name)
+            }
+            arg1 = &argValue
+        }
+
+        v.Execute(ctx, arg0, arg1)
+    case setup.AutoSetupCommand:
+
+        v.Execute(ctx)
+    case setup.LimitSetupCommand:
+        var arg0 int
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt0.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt0.Name)
+            }
+
+            if err := cmd.Properties().Arguments[0].ValidateInteger(int(argValue)); err != nil {
+                return err
+            }
+            arg0 = int(argValue)
+        }
+
+        v.Execute(ctx, arg0)
+    case setup.PanelPrioritySetupCommand:
+        var arg0 int
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt0.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt0.Name)
+            }
+            arg0 = int(argValue)
+        }
+        var arg1 *string
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            arg1 = nil
+        } else { 
+            argValue, ok := opt1.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt1.Name)
+            }
+            arg1 = &argValue
+        }
+        var arg2 *string
+
+        opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+        if !ok2 {
+            arg2 = nil
+        } else { 
+            argValue, ok := opt2.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt2.Name)
+            }
+            arg2 = &argValue
+        }
+
+        v.Execute(ctx, arg0, arg1, arg2)
+    case setup.PrioritySetupCommand:
+        var arg0 string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = argValue
+        }
+        var arg1 *uint64
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            arg1 = nil
+        } else {
+            raw, ok := opt1.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a snowflake", opt1.Name)
+            }
+
+            argValue, err := strconv.ParseUint(raw, 10, 64)
+            if err != nil {
+                return fmt.Errorf("option %s was not a valid snowflake", opt1.Name)
+            }
+
+            if err := cmd.Properties().Arguments[1].ValidateChannel(ctx.Interaction.Data.Resolved, argValue); err != nil {
+                return err
+            }
+            arg1 = &argValue
+        }
+
+        v.Execute(ctx, arg0, arg1)
+    case setup.SetupCommand:
+
+        v.Execute(ctx)
+    case setup.SlaSetupCommand:
+        var arg0 int
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt0.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt0.Name)
+            }
+            arg0 = int(argValue)
+        }
+        var arg1 *int
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            arg1 = nil
+        } else { 
+            argValue, ok := opt1.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt1.Name)
+            }
+            tmp := int(argValue)
+            arg1 = &tmp
+        }
+        var arg2 *int
+
+        opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+        if !ok2 {
+            arg2 = nil
+        } else { 
+            argValue, ok := opt2.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt2.Name)
+            }
+            tmp := int(argValue)
+            arg2 = &tmp
+        }
+        var arg3 *uint64
+
+        opt3, ok3 := findOption(cmd.Properties().Arguments[3], options)
+        if !ok3 {
+            arg3 = nil
         } else {
-            raw, ok := opt0.Value.(string)
+            raw, ok := opt3.Value.(string)
             if !ok {
-                return fmt.Errorf("option %s was not a snowflake", opt0.Name)
+                return fmt.Errorf("option %s was not a snowflake", opt3.Name)
             }
 
             argValue, err := strconv.ParseUint(raw, 10, 64)
             if err != nil {
-                return fmt.Errorf("option %s was not a valid snowflake", opt0.Name)
+                return fmt.Errorf("option %s was not a valid snowflake", opt3.Name)
             }
-            arg0 = argValue
+            arg3 = &argValue
         }
 
-        v.Execute(ctx, arg0)
-    case settings.RemoveSupportCommand:
-        var arg0 uint64
+        v.Execute(ctx, arg0, arg1, arg2, arg3)
+    case setup.SnoozeSetupCommand:
+        var arg0 *uint64
 
         opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
         if !ok0 {
-            return ErrArgumentNotFound
+            arg0 = nil
         } else {
             raw, ok := opt0.Value.(string)
             if !ok {
@@ -370,34 +771,14 @@ func callCommand(
             if err != nil {
                 return fmt.Errorf("option %s was not a valid snowflake", opt0.Name)
             }
-            arg0 = argValue
-        }
-
-        v.Execute(ctx, arg0)
-    case settings.ViewStaffCommand:
-
-        v.Execute(ctx)
-    case setup.AutoSetupCommand:
-
-        v.Execute(ctx)
-    case setup.LimitSetupCommand:
-        var arg0 int
 
-        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
-        if !ok0 {
-            return ErrArgumentNotFound
-        } else { 
-            argValue, ok := opt0.Value.(float64)
-            if !ok {
-                return fmt.Errorf("option %s was not a float64", opt0.Name)
+            if err := cmd.Properties().Arguments[0].ValidateChannel(ctx.Interaction.Data.Resolved, argValue); err != nil {
+                return err
             }
-            arg0 = int(argValue)
+            arg0 = &argValue
         }
 
         v.Execute(ctx, arg0)
-    case setup.SetupCommand:
-
-        v.Execute(ctx)
     case setup.ThreadsSetupCommand:
         var arg0 bool
 
@@ -428,6 +809,10 @@ func callCommand(
             if err != nil {
                 return fmt.Errorf("option %s was not a valid snowflake", opt1.Name)
             }
+
+            if err := cmd.Properties().Arguments[1].ValidateChannel(ctx.Interaction.Data.Resolved, argValue); err != nil {
+                return err
+            }
             arg1 = &argValue
         }
 
@@ -544,6 +929,38 @@ func callCommand(
     case tickets.AddCommand:
         var arg0 uint64
 
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else {
+            raw, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a snowflake", opt0.Name)
+            }
+
+            argValue, err := strconv.ParseUint(raw, 10, 64)
+            if err != nil {
+                return fmt.Errorf("option %s was not a valid snowflake", opt0.Name)
+            }
+            arg0 = argValue
+        }
+        var arg1 *string
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            arg1 = nil
+        } else { 
+            argValue, ok := opt1.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt1.Name)
+            }
+            arg1 = &argValue
+        }
+
+        v.Execute(ctx, arg0, arg1)
+    case tickets.ClaimAddCommand:
+        var arg0 uint64
+
         opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
         if !ok0 {
             return ErrArgumentNotFound
@@ -564,6 +981,125 @@ func callCommand(
     case tickets.ClaimCommand:
 
         v.Execute(ctx)
+    case tickets.ClaimRemoveCommand:
+        var arg0 uint64
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else {
+            raw, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a snowflake", opt0.Name)
+            }
+
+            argValue, err := strconv.ParseUint(raw, 10, 64)
+            if err != nil {
+                return fmt.Errorf("option %s was not a valid snowflake", opt0.Name)
+            }
+            arg0 = argValue
+        }
+
+        v.Execute(ctx, arg0)
+    case tickets.ClaimSelfCommand:
+
+        v.Execute(ctx)
+    case tickets.CloseAllCommand:
+        var arg0 *int
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            arg0 = nil
+        } else { 
+            argValue, ok := opt0.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt0.Name)
+            }
+            tmp := int(argValue)
+            arg0 = &tmp
+        }
+        var arg1 *string
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            arg1 = nil
+        } else { 
+            argValue, ok := opt1.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt1.Name)
+            }
+            arg1 = &argValue
+        }
+        var arg2 *string
+
+        opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+        if !ok2 {
+            arg2 = nil
+        } else { 
+            argValue, ok := opt2.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt2.Name)
+            }
+            arg2 = &argValue
+        }
+        var arg3 *int
+
+        opt3, ok3 := findOption(cmd.Properties().Arguments[3], options)
+        if !ok3 {
+            arg3 = nil
+        } else { 
+            argValue, ok := opt3.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt3.Name)
+            }
+            tmp := int(argValue)
+            arg3 = &tmp
+        }
+        var arg4 *bool
+
+        opt4, ok4 := findOption(cmd.Properties().Arguments[4], options)
+        if !ok4 {
+            arg4 = nil
+        } else { 
+            argValue, ok := opt4.Value.(bool)
+            if !ok {
+                return fmt.Errorf("option %s was not a bool", opt4.Name)
+            }
+            arg4 = &argValue
+
+            
+        }
+        var arg5 *uint64
+
+        opt5, ok5 := findOption(cmd.Properties().Arguments[5], options)
+        if !ok5 {
+            arg5 = nil
+        } else {
+            raw, ok := opt5.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a snowflake", opt5.Name)
+            }
+
+            argValue, err := strconv.ParseUint(raw, 10, 64)
+            if err != nil {
+                return fmt.Errorf("option %s was not a valid snowflake", opt5.Name)
+            }
+            arg5 = &argValue
+        }
+        var arg6 *string
+
+        opt6, ok6 := findOption(cmd.Properties().Arguments[6], options)
+        if !ok6 {
+            arg6 = nil
+        } else { 
+            argValue, ok := opt6.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt6.Name)
+            }
+            arg6 = &argValue
+        }
+
+        v.Execute(ctx, arg0, arg1, arg2, arg3, arg4, arg5, arg6)
     case tickets.CloseCommand:
         var arg0 *string
 
@@ -590,6 +1126,10 @@ func callCommand(
             if !ok {
                 return fmt.Errorf("option %s was not a float64", opt0.Name)
             }
+
+            if err := cmd.Properties().Arguments[0].ValidateInteger(int(argValue)); err != nil {
+                return err
+            }
             tmp := int(argValue)
             arg0 = &tmp
         }
@@ -603,10 +1143,29 @@ func callCommand(
             if !ok {
                 return fmt.Errorf("option %s was not a string", opt1.Name)
             }
+
+            if err := cmd.Properties().Arguments[1].ValidateString(argValue); err != nil {
+                return err
+            }
             arg1 = &argValue
         }
 
         v.Execute(ctx, arg0, arg1)
+    case tickets.MergeCommand:
+        var arg0 int
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt0.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt0.Name)
+            }
+            arg0 = int(argValue)
+        }
+
+        v.Execute(ctx, arg0)
     case tickets.NotesCommand:
 
         v.Execute(ctx)
@@ -628,6 +1187,71 @@ func callCommand(
         }
 
         v.Execute(ctx, arg0)
+    case tickets.PriorityCommand:
+        var arg0 string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = argValue
+        }
+
+        v.Execute(ctx, arg0)
+    case tickets.RemindCommand:
+
+        v.Execute(ctx)
+    case tickets.RemindListCommand:
+
+        v.Execute(ctx)
+    case tickets.RemindSetCommand:
+        var arg0 string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = argValue
+        }
+        var arg1 string
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt1.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt1.Name)
+            }
+            arg1 = argValue
+        }
+        var arg2 *uint64
+
+        opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+        if !ok2 {
+            arg2 = nil
+        } else {
+            raw, ok := opt2.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a snowflake", opt2.Name)
+            }
+
+            argValue, err := strconv.ParseUint(raw, 10, 64)
+            if err != nil {
+                return fmt.Errorf("option %s was not a valid snowflake", opt2.Name)
+            }
+            arg2 = &argValue
+        }
+
+        v.Execute(ctx, arg0, arg1, arg2)
     case tickets.RemoveCommand:
         var arg0 uint64
 
@@ -678,6 +1302,33 @@ func callCommand(
         }
 
         v.Execute(ctx, arg0)
+    case tickets.SnoozeCommand:
+        var arg0 string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = argValue
+        }
+        var arg1 *string
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            arg1 = nil
+        } else { 
+            argValue, ok := opt1.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt1.Name)
+            }
+            arg1 = &argValue
+        }
+
+        v.Execute(ctx, arg0, arg1)
     case tickets.StartTicketCommand:
 
         v.Execute(ctx)
@@ -8,19 +8,20 @@ import (
 	"github.com/TicketsBot/common/premium"
 	"github.com/TicketsBot/common/sentry"
 	"github.com/TicketsBot/worker"
-	"github.com/TicketsBot/worker/bot/blacklist"
 	"github.com/TicketsBot/worker/bot/command"
 	cmdcontext "github.com/TicketsBot/worker/bot/command/context"
 	"github.com/TicketsBot/worker/bot/command/impl/tags"
 	cmdregistry "github.com/TicketsBot/worker/bot/command/registry"
 	"github.com/TicketsBot/worker/bot/customisation"
 	"github.com/TicketsBot/worker/bot/dbclient"
-	"github.com/TicketsBot/worker/bot/metrics/prometheus"
-	"github.com/TicketsBot/worker/bot/metrics/statsd"
+	"github.com/TicketsBot/worker/bot/lifecycle"
+	"github.com/TicketsBot/worker/bot/middleware"
+	"github.com/TicketsBot/worker/bot/tracing"
 	"github.com/TicketsBot/worker/bot/utils"
 	"github.com/TicketsBot/worker/config"
 	"github.com/TicketsBot/worker/i18n"
 	"github.com/rxdn/gdl/objects/interaction"
+	"go.opentelemetry.io/otel/attribute"
 	"golang.org/x/sync/errgroup"
 	"runtime/debug"
 	"time"
@@ -30,8 +31,10 @@ import (
 // (defaultDefer, error)
 func executeCommand(
 	ctx context.Context,
+	manager *lifecycle.Manager,
 	worker *worker.Context,
 	registry cmdregistry.Registry,
+	chain middleware.Chain,
 	data interaction.ApplicationCommandInteraction,
 	responseCh chan interaction.ApplicationCommandCallbackData,
 ) (bool, error) {
@@ -61,6 +64,7 @@ func executeCommand(
 		ok = true
 	}
 
+	name := data.Data.Name
 	options := data.Data.Options
 	for len(options) > 0 && options[0].Value == nil { // Value and Options are mutually exclusive, value is never present on subcommands
 		subCommand := options[0]
@@ -78,12 +82,13 @@ func executeCommand(
 			return false, fmt.Errorf("subcommand %s does not exist for command %s", subCommand.Name, cmd.Properties().Name)
 		}
 
+		name += " " + subCommand.Name
 		options = subCommand.Options
 	}
 
 	properties := cmd.Properties()
 
-	go func() {
+	manager.Go(func() {
 		defer func() {
 			if r := recover(); r != nil {
 				fmt.Printf("Recovering panicking goroutine while executing command %s: %v\n", properties.Name, r)
@@ -91,6 +96,14 @@ func executeCommand(
 			}
 		}()
 
+		ctx, span := tracing.Start(ctx, "command "+properties.Name,
+			attribute.Int64("discord.interaction_id", int64(data.Id)),
+			attribute.Int64("discord.guild_id", int64(data.GuildId.Value)),
+		)
+		defer span.End()
+
+		worker := worker.WithContext(ctx)
+
 		lookupCtx, cancelLookupCtx := context.WithTimeout(ctx, time.Second*2)
 		defer cancelLookupCtx()
 
@@ -141,71 +154,43 @@ func executeCommand(
 
 		interactionContext := cmdcontext.NewSlashCommandContext(ctx, worker, data, premiumLevel, responseCh)
 
-		// Check if the guild is globally blacklisted
-		if blacklist.IsGuildBlacklisted(data.GuildId.Value) {
-			interactionContext.Reply(customisation.Red, i18n.TitleBlacklisted, i18n.MessageBlacklisted)
-			return
-		}
-
-		if properties.PermissionLevel > permLevel {
-			interactionContext.Reply(customisation.Red, i18n.Error, i18n.MessageNoPermission)
-			return
-		}
-
-		if properties.AdminOnly && !utils.IsBotAdmin(interactionContext.UserId()) {
-			interactionContext.Reply(customisation.Red, i18n.Error, i18n.MessageOwnerOnly)
-			return
-		}
-
-		if properties.HelperOnly && !utils.IsBotHelper(interactionContext.UserId()) {
-			interactionContext.Reply(customisation.Red, i18n.Error, i18n.MessageNoPermission)
-			return
-		}
-
-		if properties.PremiumOnly && premiumLevel == premium.None {
-			interactionContext.Reply(customisation.Red, i18n.TitlePremiumOnly, i18n.MessagePremium)
-			return
+		requirements := middleware.Requirements{
+			PermissionLevel: properties.PermissionLevel,
+			PremiumOnly:     properties.PremiumOnly,
+			AdminOnly:       properties.AdminOnly,
+			HelperOnly:      properties.HelperOnly,
+			Cooldown:        properties.Cooldown,
 		}
 
-		// Check for user blacklist - cannot parallelise as relies on permission level
-		// If data.Member is nil, it does not matter, as it is not checked if the command is not executed in a guild
-		blacklisted, err := interactionContext.IsBlacklisted(lookupCtx)
-		cancelLookupCtx()
-		if err != nil {
-			interactionContext.HandleError(err)
-			return
-		}
-
-		if blacklisted {
-			interactionContext.Reply(customisation.Red, i18n.TitleBlacklisted, i18n.MessageBlacklisted)
-			return
-		}
-
-		statsd.Client.IncrementKey(statsd.KeySlashCommands)
-		statsd.Client.IncrementKey(statsd.KeyCommands)
-		prometheus.LogCommand(data.Data.Name)
-
-		defer close(responseCh)
-
-		if err := callCommand(cmd, &interactionContext, options); err != nil {
-			if errors.Is(err, ErrArgumentNotFound) {
-				if worker.IsWhitelabel {
-					content := `This command registration is outdated. Please ask the server administrators to visit the whitelabel dashboard and press "Create Slash Commands" again.`
-					embed := utils.BuildEmbedRaw(customisation.GetDefaultColour(customisation.Red), "Outdated Command", content, nil, premium.Whitelabel)
-					res := command.NewEphemeralEmbedMessageResponse(embed)
-					responseCh <- res.IntoApplicationCommandData()
-
-					return
+		inv := middleware.NewInvocation(lookupCtx, &interactionContext, middleware.InvocationCommand, name, requirements).
+			WithPermissionLevel(permLevel)
+
+		chain.Then(func(*middleware.Invocation) {
+			defer close(responseCh)
+
+			if err := callCommand(cmd, &interactionContext, options); err != nil {
+				var invalidErr *command.InvalidArgumentError
+				if errors.As(err, &invalidErr) {
+					interactionContext.Reply(customisation.Red, i18n.Error, invalidErr.Argument.InvalidMessage)
+				} else if errors.Is(err, ErrArgumentNotFound) {
+					if worker.IsWhitelabel {
+						content := `This command registration is outdated. Please ask the server administrators to visit the whitelabel dashboard and press "Create Slash Commands" again.`
+						embed := utils.BuildEmbedRaw(customisation.GetDefaultColour(customisation.Red), "Outdated Command", content, nil, premium.Whitelabel)
+						res := command.NewEphemeralEmbedMessageResponse(embed)
+						responseCh <- res.IntoApplicationCommandData()
+
+						return
+					} else {
+						res := command.NewEphemeralTextMessageResponse("argument is missing")
+						responseCh <- res.IntoApplicationCommandData()
+					}
 				} else {
-					res := command.NewEphemeralTextMessageResponse("argument is missing")
-					responseCh <- res.IntoApplicationCommandData()
+					interactionContext.HandleError(err)
+					return
 				}
-			} else {
-				interactionContext.HandleError(err)
-				return
 			}
-		}
-	}()
+		})(inv)
+	})
 
 	return properties.DefaultEphemeral, nil
 }
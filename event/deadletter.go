@@ -0,0 +1,108 @@
+package event
+
+import (
+	"context"
+	"github.com/TicketsBot/common/eventforwarding"
+	"github.com/TicketsBot/worker/bot/metrics/prometheus"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.uber.org/zap"
+	"strconv"
+	"time"
+)
+
+const (
+	SourceKafka = "kafka"
+	SourceHttp  = "http"
+)
+
+const deadLetterPublishTimeout = time.Second * 10
+
+// DeadLetter is the payload written to the dead-letter topic when an event could not be processed. The original event
+// is kept intact so that it can be re-driven by cmd/replaydlq.
+type DeadLetter struct {
+	Event     eventforwarding.Event `json:"event"`
+	Reason    string                `json:"reason"`
+	Retryable bool                  `json:"retryable"`
+	Attempts  int                   `json:"attempts"`
+	Source    string                `json:"source"`
+	FailedAt  time.Time             `json:"failed_at"`
+}
+
+type DeadLetterQueue struct {
+	logger *zap.Logger
+	client *kgo.Client
+	topic  string
+}
+
+func NewDeadLetterQueue(logger *zap.Logger, brokers []string, topic string) (*DeadLetterQueue, error) {
+	client, err := kgo.NewClient(
+		kgo.SeedBrokers(brokers...),
+		kgo.DefaultProduceTopic(topic),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeadLetterQueue{
+		logger: logger,
+		client: client,
+		topic:  topic,
+	}, nil
+}
+
+func (q *DeadLetterQueue) Publish(ctx context.Context, deadLetter DeadLetter) error {
+	marshalled, err := json.Marshal(deadLetter)
+	if err != nil {
+		return err
+	}
+
+	return q.client.ProduceSync(ctx, &kgo.Record{
+		Topic: q.topic,
+		Key:   []byte(strconv.FormatUint(deadLetter.Event.BotId, 10)),
+		Value: marshalled,
+	}).FirstErr()
+}
+
+func (q *DeadLetterQueue) Close() {
+	q.client.Close()
+}
+
+// handleFailedEvent writes an event that failed all of its attempts to the dead-letter topic. If no dead-letter queue
+// is configured, the event is logged and dropped.
+func handleFailedEvent(logger *zap.Logger, dlq *DeadLetterQueue, event eventforwarding.Event, source string, attempts int, cause error) {
+	retryable := isRetryable(cause)
+	prometheus.DeadLetteredEvents.WithLabelValues(source, strconv.FormatBool(retryable)).Inc()
+
+	logger = logger.With(
+		zap.Error(cause),
+		zap.String("source", source),
+		zap.Int("attempts", attempts),
+		zap.Bool("retryable", retryable),
+		zap.Uint64("bot_id", event.BotId),
+	)
+
+	if dlq == nil {
+		logger.Error("Failed to handle event, no dead-letter topic configured so dropping", zap.ByteString("payload", event.Event))
+		return
+	}
+
+	deadLetter := DeadLetter{
+		Event:     event,
+		Reason:    cause.Error(),
+		Retryable: retryable,
+		Attempts:  attempts,
+		Source:    source,
+		FailedAt:  time.Now(),
+	}
+
+	// Don't use the event's context, as it may have been cancelled by shutdown
+	ctx, cancel := context.WithTimeout(context.Background(), deadLetterPublishTimeout)
+	defer cancel()
+
+	if err := dlq.Publish(ctx, deadLetter); err != nil {
+		logger.Error("Failed to write event to dead-letter topic", zap.NamedError("publish_error", err), zap.ByteString("payload", event.Event))
+		return
+	}
+
+	logger.Warn("Failed to handle event, wrote to dead-letter topic")
+}
@@ -0,0 +1,124 @@
+package event
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/TicketsBot/common/eventforwarding"
+	"github.com/TicketsBot/worker/bot/metrics/prometheus"
+	"github.com/TicketsBot/worker/bot/redis"
+	"github.com/TicketsBot/worker/config"
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+	"go.uber.org/zap"
+	"net/http"
+	"time"
+)
+
+const (
+	dedupTimeout = time.Second * 2
+
+	// A redelivered interaction can arrive while the first delivery is still being processed
+	duplicateInteractionPollInterval = time.Millisecond * 100
+)
+
+// eventFingerprint identifies a gateway event. Gateway payloads include the shard's sequence number, so two events
+// with the same body from the same shard are the same event.
+func eventFingerprint(event eventforwarding.Event) string {
+	hash := sha256.New()
+	_, _ = fmt.Fprintf(hash, "%d:%d:", event.BotId, event.ShardId)
+	hash.Write(event.Event)
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// claimEvent returns whether the event should be processed. If Redis is unavailable, the event is processed anyway,
+// as processing an event twice is better than not processing it at all.
+func claimEvent(logger *zap.Logger, event eventforwarding.Event, source string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), dedupTimeout)
+	defer cancel()
+
+	claimed, err := redis.ClaimEvent(ctx, eventFingerprint(event))
+	if err != nil {
+		logger.Warn("Failed to check whether event is a duplicate", zap.Error(err))
+		return true
+	}
+
+	if !claimed {
+		prometheus.DuplicateEvents.WithLabelValues(source).Inc()
+	}
+
+	return claimed
+}
+
+// releaseEvent is called when an event fails, so that it isn't skipped when it is replayed from the dead-letter queue
+func releaseEvent(logger *zap.Logger, event eventforwarding.Event) {
+	ctx, cancel := context.WithTimeout(context.Background(), dedupTimeout)
+	defer cancel()
+
+	if err := redis.ReleaseEvent(ctx, eventFingerprint(event)); err != nil {
+		logger.Warn("Failed to release event dedup key", zap.Error(err))
+	}
+}
+
+// claimInteraction returns whether the interaction should be processed, failing open in the same way as claimEvent
+func claimInteraction(ctx context.Context, interactionId uint64) bool {
+	ctx, cancel := context.WithTimeout(ctx, dedupTimeout)
+	defer cancel()
+
+	claimed, err := redis.ClaimInteraction(ctx, interactionId)
+	if err != nil {
+		logrus.Warnf("error checking whether interaction %d is a duplicate: %v", interactionId, err)
+		return true
+	}
+
+	return claimed
+}
+
+// respondDuplicate sends the response that was sent for the first delivery of the interaction. If the first delivery
+// hasn't responded yet, it waits until the callback timeout before giving up.
+func respondDuplicate(ctx *gin.Context, interactionId uint64) {
+	deadline := time.Now().Add(config.Conf.Discord.CallbackTimeout)
+
+	for {
+		res, err := redis.GetInteractionResponse(ctx, interactionId)
+		if err != nil {
+			logrus.Warnf("error retrieving cached response for interaction %d: %v", interactionId, err)
+			break
+		}
+
+		if res != nil {
+			prometheus.DuplicateInteractions.WithLabelValues("true").Inc()
+
+			ctx.Data(200, "application/json; charset=utf-8", res)
+			ctx.Writer.Flush()
+			return
+		}
+
+		if time.Now().Add(duplicateInteractionPollInterval).After(deadline) {
+			break
+		}
+
+		time.Sleep(duplicateInteractionPollInterval)
+	}
+
+	prometheus.DuplicateInteractions.WithLabelValues("false").Inc()
+	ctx.JSON(http.StatusConflict, newErrorResponse(fmt.Errorf("interaction %d is already being processed", interactionId)))
+}
+
+// storeResponse caches the initial response to the interaction for respondDuplicate
+func storeResponse(interactionId uint64, res any) {
+	marshalled, err := json.Marshal(res)
+	if err != nil {
+		logrus.Warnf("error marshalling response for interaction %d: %v", interactionId, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dedupTimeout)
+	defer cancel()
+
+	if err := redis.StoreInteractionResponse(ctx, interactionId, marshalled); err != nil {
+		logrus.Warnf("error caching response for interaction %d: %v", interactionId, err)
+	}
+}
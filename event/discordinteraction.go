@@ -0,0 +1,236 @@
+package event
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/TicketsBot/common/eventforwarding"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/config"
+	"github.com/gin-gonic/gin"
+	"github.com/rxdn/gdl/objects/interaction"
+	"go.uber.org/zap"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// Interactions with large amounts of resolved data can be sizeable, but are nowhere near this
+	maxInteractionSize = 4 * 1024 * 1024
+
+	// Discord sends requests as soon as the interaction is created, so anything older than this is a replay
+	maxSignatureAge = time.Minute * 5
+
+	// Whitelabel tokens can be changed from the dashboard, so don't hold on to them for long
+	botResolverTtl = time.Minute
+)
+
+var (
+	errUnknownApplication = errors.New("unknown application")
+	errInvalidSignature   = errors.New("invalid request signature")
+)
+
+// discordInteractionHandler accepts interactions sent directly by Discord, rather than wrapped by the proxy, so that
+// the worker can be used as an application's Interactions Endpoint URL. Requests are verified against the public key
+// of the application they are for, before being passed to the same pipeline as proxied interactions.
+func discordInteractionHandler(
+	logger *zap.Logger,
+	recorder *Recorder,
+	dispatcher *interactionDispatcher,
+	resolver *botResolver,
+) func(*gin.Context) {
+	return func(ctx *gin.Context) {
+		body, err := io.ReadAll(io.LimitReader(ctx.Request.Body, maxInteractionSize))
+		if err != nil {
+			ctx.JSON(400, newErrorResponse(err))
+			return
+		}
+
+		var metadata struct {
+			Type          interaction.InteractionType `json:"type"`
+			ApplicationId uint64                      `json:"application_id,string"`
+		}
+
+		if err := json.Unmarshal(body, &metadata); err != nil {
+			ctx.JSON(400, newErrorResponse(err))
+			return
+		}
+
+		bot, err := resolver.resolve(ctx, metadata.ApplicationId)
+		if err != nil {
+			if errors.Is(err, errUnknownApplication) {
+				ctx.JSON(401, newErrorResponse(err))
+			} else {
+				logger.Error("Failed to resolve application", zap.Uint64("application_id", metadata.ApplicationId), zap.Error(err))
+				ctx.JSON(500, newErrorResponse(err))
+			}
+
+			return
+		}
+
+		signature := ctx.GetHeader("X-Signature-Ed25519")
+		timestamp := ctx.GetHeader("X-Signature-Timestamp")
+		if err := verifyInteractionSignature(bot.publicKey, signature, timestamp, body, time.Now()); err != nil {
+			ctx.JSON(401, newErrorResponse(err))
+			return
+		}
+
+		// Discord sends a PING when the endpoint URL is saved, and won't accept the URL unless we respond with a PONG
+		if metadata.Type == interaction.InteractionTypePing {
+			ctx.JSON(200, interaction.NewResponsePong())
+			return
+		}
+
+		payload := eventforwarding.Interaction{
+			BotToken:        bot.token,
+			BotId:           bot.botId,
+			IsWhitelabel:    bot.isWhitelabel,
+			InteractionType: metadata.Type,
+			Event:           body,
+		}
+
+		recorder.RecordInteraction(SourceHttp, payload)
+		dispatcher.dispatch(ctx, payload)
+	}
+}
+
+// verifyInteractionSignature checks that the request was signed by Discord, as described at
+// https://discord.com/developers/docs/interactions/overview#setting-up-an-endpoint-validating-security-request-headers
+func verifyInteractionSignature(publicKey ed25519.PublicKey, signature, timestamp string, body []byte, now time.Time) error {
+	if signature == "" || timestamp == "" {
+		return errInvalidSignature
+	}
+
+	decoded, err := hex.DecodeString(signature)
+	if err != nil || len(decoded) != ed25519.SignatureSize {
+		return errInvalidSignature
+	}
+
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errInvalidSignature
+	}
+
+	if age := now.Sub(time.Unix(unix, 0)); age > maxSignatureAge || age < -maxSignatureAge {
+		return fmt.Errorf("request timestamp is outside of the allowed window")
+	}
+
+	message := make([]byte, 0, len(timestamp)+len(body))
+	message = append(message, timestamp...)
+	message = append(message, body...)
+
+	if !ed25519.Verify(publicKey, message, decoded) {
+		return errInvalidSignature
+	}
+
+	return nil
+}
+
+type interactionBot struct {
+	token        string
+	botId        uint64
+	isWhitelabel bool
+	publicKey    ed25519.PublicKey
+}
+
+type botResolverEntry struct {
+	bot       interactionBot
+	expiresAt time.Time
+}
+
+// botResolver looks up the token and public key for an application ID. The public bot is configured through env
+// vars, while whitelabel bots are looked up by bot ID, which is the same as the application ID for all bots that can
+// be registered through the dashboard.
+type botResolver struct {
+	mu      sync.RWMutex
+	entries map[uint64]botResolverEntry
+}
+
+func newBotResolver() *botResolver {
+	return &botResolver{
+		entries: make(map[uint64]botResolverEntry),
+	}
+}
+
+func (r *botResolver) resolve(ctx context.Context, applicationId uint64) (interactionBot, error) {
+	if applicationId == 0 {
+		return interactionBot{}, errUnknownApplication
+	}
+
+	r.mu.RLock()
+	entry, ok := r.entries[applicationId]
+	r.mu.RUnlock()
+
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry.bot, nil
+	}
+
+	bot, err := r.lookup(ctx, applicationId)
+	if err != nil {
+		return interactionBot{}, err
+	}
+
+	// Only successful lookups are cached, so that requests with made up application IDs can't fill the map
+	r.mu.Lock()
+	r.entries[applicationId] = botResolverEntry{
+		bot:       bot,
+		expiresAt: time.Now().Add(botResolverTtl),
+	}
+	r.mu.Unlock()
+
+	return bot, nil
+}
+
+func (r *botResolver) lookup(ctx context.Context, applicationId uint64) (interactionBot, error) {
+	if applicationId == config.Conf.Discord.PublicBotId {
+		publicKey, err := decodePublicKey(config.Conf.Discord.PublicKey)
+		if err != nil {
+			return interactionBot{}, fmt.Errorf("public bot has no valid public key configured: %w", errUnknownApplication)
+		}
+
+		return interactionBot{
+			token:        config.Conf.Discord.Token,
+			botId:        config.Conf.Discord.PublicBotId,
+			isWhitelabel: false,
+			publicKey:    publicKey,
+		}, nil
+	}
+
+	res, err := dbclient.Client.Whitelabel.GetByBotId(ctx, applicationId)
+	if err != nil {
+		return interactionBot{}, err
+	}
+
+	if res.BotId == 0 {
+		return interactionBot{}, errUnknownApplication
+	}
+
+	publicKey, err := decodePublicKey(res.PublicKey)
+	if err != nil {
+		return interactionBot{}, fmt.Errorf("whitelabel bot has no valid public key: %w", errUnknownApplication)
+	}
+
+	return interactionBot{
+		token:        res.Token,
+		botId:        res.BotId,
+		isWhitelabel: true,
+		publicKey:    publicKey,
+	}, nil
+}
+
+func decodePublicKey(key string) (ed25519.PublicKey, error) {
+	decoded, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(decoded) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("public key has length %d, expected %d", len(decoded), ed25519.PublicKeySize)
+	}
+
+	return decoded, nil
+}
@@ -0,0 +1,43 @@
+package event
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"github.com/stretchr/testify/require"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func signInteraction(t *testing.T, privateKey ed25519.PrivateKey, timestamp string, body []byte) string {
+	t.Helper()
+	return hex.EncodeToString(ed25519.Sign(privateKey, append([]byte(timestamp), body...)))
+}
+
+func TestVerifyInteractionSignature(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	now := time.Now()
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	body := []byte(`{"type":1,"application_id":"1"}`)
+	signature := signInteraction(t, privateKey, timestamp, body)
+
+	require.NoError(t, verifyInteractionSignature(publicKey, signature, timestamp, body, now))
+	require.Error(t, verifyInteractionSignature(publicKey, signature, timestamp, []byte(`{"type":2}`), now))
+	require.Error(t, verifyInteractionSignature(publicKey, "", timestamp, body, now))
+	require.Error(t, verifyInteractionSignature(publicKey, "not hex", timestamp, body, now))
+}
+
+func TestVerifyInteractionSignatureStale(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	signedAt := time.Now().Add(-maxSignatureAge * 2)
+	timestamp := strconv.FormatInt(signedAt.Unix(), 10)
+	body := []byte(`{"type":1}`)
+	signature := signInteraction(t, privateKey, timestamp, body)
+
+	require.NoError(t, verifyInteractionSignature(publicKey, signature, timestamp, body, signedAt))
+	require.Error(t, verifyInteractionSignature(publicKey, signature, timestamp, body, time.Now()))
+}
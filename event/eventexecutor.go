@@ -7,16 +7,26 @@ import (
 	"github.com/TicketsBot/worker"
 	"github.com/TicketsBot/worker/bot/listeners"
 	"github.com/TicketsBot/worker/bot/metrics/prometheus"
+	"github.com/TicketsBot/worker/bot/tracing"
 	"github.com/getsentry/sentry-go"
 	"github.com/rxdn/gdl/gateway/payloads"
+	"go.opentelemetry.io/otel/attribute"
 )
 
-func execute(c *worker.Context, event []byte) error {
+func execute(ctx context.Context, c *worker.Context, event []byte) error {
 	var payload payloads.Payload
 	if err := json.Unmarshal(event, &payload); err != nil {
-		return errors.New(fmt.Sprintf("error whilst decoding event data: %s (data: %s)", err.Error(), string(event)))
+		return newPermanentError(errors.New(fmt.Sprintf("error whilst decoding event data: %s (data: %s)", err.Error(), string(event))))
 	}
 
+	ctx, otelSpan := tracing.Start(ctx, "event "+payload.EventName,
+		attribute.Int64("discord.bot_id", int64(c.BotId)),
+		attribute.Int("discord.shard_id", c.ShardId),
+	)
+	defer otelSpan.End()
+
+	c = c.WithContext(ctx)
+
 	span := sentry.StartTransaction(context.Background(), "Handle Event")
 	span.SetTag("event", payload.EventName)
 	defer span.Finish()
@@ -24,6 +34,7 @@ func execute(c *worker.Context, event []byte) error {
 	prometheus.Events.WithLabelValues(payload.EventName).Inc()
 
 	if err := listeners.HandleEvent(c, span, payload); err != nil {
+		tracing.RecordError(otelSpan, err)
 		return err
 	}
 
@@ -0,0 +1,202 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"github.com/TicketsBot/worker/bot/blacklist"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/lifecycle"
+	"github.com/TicketsBot/worker/bot/listeners/messagequeue"
+	"github.com/TicketsBot/worker/config"
+	"github.com/TicketsBot/worker/i18n"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"github.com/rxdn/gdl/cache"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type dependencyCheck struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+type dependencyStatus struct {
+	Healthy bool   `json:"healthy"`
+	Latency int64  `json:"latency_ms"`
+	Error   string `json:"error,omitempty"`
+}
+
+type readinessResponse struct {
+	Ready        bool                        `json:"ready"`
+	Draining     bool                        `json:"draining"`
+	Dependencies map[string]dependencyStatus `json:"dependencies"`
+}
+
+type statusResponse struct {
+	Mode         config.WorkerMode             `json:"mode"`
+	Draining     bool                          `json:"draining"`
+	InFlight     int                           `json:"in_flight"`
+	Kafka        *kafkaStatus                  `json:"kafka,omitempty"`
+	Blacklist    blacklistStatus               `json:"blacklist"`
+	I18n         i18nStatus                    `json:"i18n"`
+	MessageQueue []messagequeue.ListenerStatus `json:"message_queue"`
+}
+
+type kafkaStatus struct {
+	Lag   *KafkaLag `json:"lag,omitempty"`
+	Error string    `json:"error,omitempty"`
+}
+
+type blacklistStatus struct {
+	LastRefreshed *time.Time `json:"last_refreshed"`
+	AgeSeconds    *float64   `json:"age_seconds"`
+}
+
+type i18nStatus struct {
+	Loaded  bool `json:"loaded"`
+	Locales int  `json:"locales"`
+}
+
+func dependencyChecks(redis *redis.Client, cache *cache.PgCache) []dependencyCheck {
+	return []dependencyCheck{
+		{
+			name: "redis",
+			check: func(ctx context.Context) error {
+				return redis.Ping(ctx).Err()
+			},
+		},
+		{
+			name:  "database",
+			check: dbclient.Ping,
+		},
+		{
+			name: "cache",
+			check: func(ctx context.Context) error {
+				return cache.Ping(ctx)
+			},
+		},
+		{
+			name: "clickhouse",
+			check: func(ctx context.Context) error {
+				if dbclient.Analytics == nil {
+					return errors.New("clickhouse is not connected")
+				}
+
+				return dbclient.Analytics.Ping(ctx)
+			},
+		},
+	}
+}
+
+// livenessHandler only reports whether the HTTP server is able to respond, as restarting the pod won't fix a
+// dependency being down
+func livenessHandler(ctx *gin.Context) {
+	ctx.JSON(200, successResponse)
+}
+
+// readinessHandler checks each dependency concurrently, each with its own timeout. The worker is not ready if any
+// dependency is down, or if it has begun shutting down.
+func readinessHandler(redis *redis.Client, cache *cache.PgCache, manager *lifecycle.Manager) func(*gin.Context) {
+	checks := dependencyChecks(redis, cache)
+
+	return func(ctx *gin.Context) {
+		res := readinessResponse{
+			Ready:        true,
+			Draining:     manager.Draining(),
+			Dependencies: make(map[string]dependencyStatus, len(checks)),
+		}
+
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+
+		for _, check := range checks {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				status := runDependencyCheck(ctx.Request.Context(), check)
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				res.Dependencies[check.name] = status
+				if !status.Healthy {
+					res.Ready = false
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		if res.Draining {
+			res.Ready = false
+		}
+
+		if res.Ready {
+			ctx.JSON(200, res)
+		} else {
+			ctx.JSON(http.StatusServiceUnavailable, res)
+		}
+	}
+}
+
+func runDependencyCheck(ctx context.Context, check dependencyCheck) dependencyStatus {
+	ctx, cancel := context.WithTimeout(ctx, config.Conf.Health.CheckTimeout)
+	defer cancel()
+
+	start := time.Now()
+	err := check.check(ctx)
+
+	status := dependencyStatus{
+		Healthy: err == nil,
+		Latency: time.Since(start).Milliseconds(),
+	}
+
+	if err != nil {
+		status.Error = err.Error()
+	}
+
+	return status
+}
+
+// statusHandler reports the state of the worker's background processes. lagMonitor is nil when the worker is not
+// consuming from Kafka.
+func statusHandler(manager *lifecycle.Manager, lagMonitor *KafkaLagMonitor) func(*gin.Context) {
+	return func(ctx *gin.Context) {
+		res := statusResponse{
+			Mode:     config.Conf.WorkerMode,
+			Draining: manager.Draining(),
+			InFlight: manager.InFlight(),
+			I18n: i18nStatus{
+				Loaded:  i18n.Loaded(),
+				Locales: len(i18n.Locales),
+			},
+			MessageQueue: messagequeue.Status(),
+		}
+
+		if lastRefreshed := blacklist.LastRefreshed(); !lastRefreshed.IsZero() {
+			age := time.Since(lastRefreshed).Seconds()
+
+			res.Blacklist = blacklistStatus{
+				LastRefreshed: &lastRefreshed,
+				AgeSeconds:    &age,
+			}
+		}
+
+		if lagMonitor != nil {
+			lagCtx, cancel := context.WithTimeout(ctx.Request.Context(), config.Conf.Health.StatusTimeout)
+			defer cancel()
+
+			lag, err := lagMonitor.Lag(lagCtx)
+			if err != nil {
+				res.Kafka = &kafkaStatus{Error: err.Error()}
+			} else {
+				res.Kafka = &kafkaStatus{Lag: &lag}
+			}
+		}
+
+		ctx.JSON(200, res)
+	}
+}
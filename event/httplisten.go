@@ -2,6 +2,7 @@ package event
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/TicketsBot/common/eventforwarding"
 	"github.com/TicketsBot/common/sentry"
@@ -10,7 +11,10 @@ import (
 	btn_manager "github.com/TicketsBot/worker/bot/button/manager"
 	"github.com/TicketsBot/worker/bot/command"
 	cmd_manager "github.com/TicketsBot/worker/bot/command/manager"
+	"github.com/TicketsBot/worker/bot/lifecycle"
 	"github.com/TicketsBot/worker/bot/metrics/prometheus"
+	"github.com/TicketsBot/worker/bot/middleware"
+	"github.com/TicketsBot/worker/bot/tracing"
 	"github.com/TicketsBot/worker/bot/utils"
 	"github.com/TicketsBot/worker/config"
 	"github.com/gin-gonic/gin"
@@ -20,6 +24,9 @@ import (
 	"github.com/rxdn/gdl/objects/interaction"
 	"github.com/rxdn/gdl/rest"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+	"net/http"
 	"strings"
 	"time"
 )
@@ -46,7 +53,17 @@ var successResponse = response{
 	Success: true,
 }
 
-func HttpListen(redis *redis.Client, cache *cache.PgCache) {
+// dlq, recorder and lagMonitor may be nil. The server stops accepting requests once the lifecycle manager begins
+// shutting down.
+func HttpListen(
+	logger *zap.Logger,
+	redis *redis.Client,
+	cache *cache.PgCache,
+	dlq *DeadLetterQueue,
+	recorder *Recorder,
+	manager *lifecycle.Manager,
+	lagMonitor *KafkaLagMonitor,
+) {
 	router := gin.New()
 
 	// Middleware
@@ -57,11 +74,38 @@ func HttpListen(redis *redis.Client, cache *cache.PgCache) {
 		router.Use(gin.Logger())
 	}
 
+	// Health routes are registered before the drain middleware, so that they keep responding while draining
+	router.GET("/healthz", livenessHandler)
+	router.GET("/readyz", readinessHandler(redis, cache, manager))
+	router.GET("/status", statusHandler(manager, lagMonitor))
+
+	router.Use(drainMiddleware(manager))
+	router.Use(tracingMiddleware)
+
 	// Routes
-	router.POST("/event", eventHandler(cache))
-	router.POST("/interaction", interactionHandler(redis, cache))
+	router.POST("/event", eventHandler(logger, cache, dlq, recorder, true))
+	dispatcher := newInteractionDispatcher(cache, manager, true)
+	router.POST("/interaction", interactionHandler(recorder, dispatcher))
+	router.POST("/discord/interactions", discordInteractionHandler(logger, recorder, dispatcher, newBotResolver()))
+
+	server := &http.Server{
+		Addr:    config.Conf.Bot.HttpAddress,
+		Handler: router,
+	}
+
+	go func() {
+		<-manager.Context().Done()
+
+		ctx, cancel := context.WithTimeout(context.Background(), config.Conf.ShutdownTimeout)
+		defer cancel()
+
+		logger.Info("Stopping HTTP server")
+		if err := server.Shutdown(ctx); err != nil {
+			logger.Warn("Failed to stop HTTP server gracefully", zap.Error(err))
+		}
+	}()
 
-	if err := router.Run(config.Conf.Bot.HttpAddress); err != nil {
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		panic(err)
 	}
 }
@@ -71,7 +115,37 @@ func metricsMiddleware(c *gin.Context) {
 	c.Next()
 }
 
-func eventHandler(cache *cache.PgCache) func(*gin.Context) {
+// drainMiddleware rejects requests once shutdown has begun, so that the proxy can retry them against another worker
+func drainMiddleware(manager *lifecycle.Manager) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		done, ok := manager.Track()
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, newErrorResponse(errors.New("worker is shutting down")))
+			return
+		}
+
+		defer done()
+		c.Next()
+	}
+}
+
+// tracingMiddleware starts a span for the request, continuing the trace started by the proxy if it sent a W3C trace
+// context
+func tracingMiddleware(c *gin.Context) {
+	ctx, span := tracing.StartServer(c.Request.Context(), c.Request.Header, c.Request.Method+" "+c.FullPath(),
+		attribute.String("http.request.method", c.Request.Method),
+		attribute.String("http.route", c.FullPath()),
+	)
+	defer span.End()
+
+	c.Request = c.Request.WithContext(ctx)
+	c.Next()
+
+	span.SetAttributes(attribute.Int("http.response.status_code", c.Writer.Status()))
+}
+
+// If dedup is enabled, events that have already been received are skipped
+func eventHandler(logger *zap.Logger, cache *cache.PgCache, dlq *DeadLetterQueue, recorder *Recorder, dedup bool) func(*gin.Context) {
 	return func(c *gin.Context) {
 		var event eventforwarding.Event
 		if err := c.BindJSON(&event); err != nil {
@@ -80,6 +154,8 @@ func eventHandler(cache *cache.PgCache) func(*gin.Context) {
 			return
 		}
 
+		recorder.RecordEvent(SourceHttp, event)
+
 		workerCtx := &worker.Context{
 			Token:        event.BotToken,
 			BotId:        event.BotId,
@@ -91,163 +167,239 @@ func eventHandler(cache *cache.PgCache) func(*gin.Context) {
 
 		c.AbortWithStatusJSON(200, successResponse)
 
-		if err := execute(workerCtx, event.Event); err != nil {
-			marshalled, _ := json.Marshal(event)
-			logrus.Warnf("error executing event: %v (payload: %s)", err, string(marshalled))
+		if dedup && !claimEvent(logger, event, SourceHttp) {
+			return
+		}
+
+		// The response has already been sent, so don't tie retries to the request context
+		if attempts, err := executeWithRetry(tracing.Detach(c.Request.Context()), workerCtx, event.Event, SourceHttp); err != nil {
+			handleFailedEvent(logger, dlq, event, SourceHttp, attempts, err)
+
+			if dedup {
+				releaseEvent(logger, event)
+			}
+		}
+	}
+}
+
+func interactionHandler(recorder *Recorder, dispatcher *interactionDispatcher) func(*gin.Context) {
+	return func(ctx *gin.Context) {
+		var payload eventforwarding.Interaction
+		if err := ctx.BindJSON(&payload); err != nil {
+			ctx.JSON(400, newErrorResponse(err))
+			return
 		}
+
+		recorder.RecordInteraction(SourceHttp, payload)
+		dispatcher.dispatch(ctx, payload)
 	}
 }
 
-func interactionHandler(redis *redis.Client, cache *cache.PgCache) func(*gin.Context) {
+// interactionDispatcher runs interactions through the command and component pipeline, writing the initial response
+// to the request. It is shared between the proxied and native interaction endpoints, so that commands are only
+// registered once.
+//
+// If dedup is enabled, redelivered interactions are sent the response that was sent for the first delivery, rather
+// than being processed again.
+type interactionDispatcher struct {
+	cache          *cache.PgCache
+	manager        *lifecycle.Manager
+	dedup          bool
+	commandManager *cmd_manager.CommandManager
+	buttonManager  *btn_manager.ComponentInteractionManager
+}
+
+func newInteractionDispatcher(cache *cache.PgCache, manager *lifecycle.Manager, dedup bool) *interactionDispatcher {
 	commandManager := new(cmd_manager.CommandManager)
 	commandManager.RegisterCommands()
 	commandManager.RunSetupFuncs()
+	commandManager.Use(
+		middleware.GuildBlacklist,
+		middleware.CommandOverrides,
+		middleware.PermissionLevel,
+		middleware.BotStaff,
+		middleware.Premium,
+		middleware.UserBlacklist,
+		middleware.Cooldown,
+		middleware.CommandMetrics,
+	)
 
 	buttonManager := btn_manager.NewButtonManager()
-	buttonManager.RegisterCommands()
+	buttonManager.RegisterCommands(manager)
+	buttonManager.Use(
+		middleware.GuildBlacklist,
+		middleware.UserBlacklist,
+		middleware.PermissionLevel,
+		middleware.Cooldown,
+	)
+
+	return &interactionDispatcher{
+		cache:          cache,
+		manager:        manager,
+		dedup:          dedup,
+		commandManager: commandManager,
+		buttonManager:  buttonManager,
+	}
+}
 
-	return func(ctx *gin.Context) {
-		var payload eventforwarding.Interaction
-		if err := ctx.BindJSON(&payload); err != nil {
-			ctx.JSON(400, newErrorResponse(err))
+func (d *interactionDispatcher) dispatch(ctx *gin.Context, payload eventforwarding.Interaction) {
+	if d.dedup {
+		var metadata interaction.InteractionMetadata
+		if err := json.Unmarshal(payload.Event, &metadata); err == nil && metadata.Id != 0 && !claimInteraction(ctx, metadata.Id) {
+			respondDuplicate(ctx, metadata.Id)
 			return
 		}
+	}
 
-		worker := &worker.Context{
-			Token:        payload.BotToken,
-			BotId:        payload.BotId,
-			IsWhitelabel: payload.IsWhitelabel,
-			Cache:        cache,
-			RateLimiter:  nil, // Use http-proxy ratelimit functionality
+	// Commands and components continue running after the initial response is sent, so they can't use the request
+	// context, which is cancelled once the handler returns
+	traceCtx := tracing.Detach(ctx.Request.Context())
+
+	worker := &worker.Context{
+		Token:        payload.BotToken,
+		BotId:        payload.BotId,
+		IsWhitelabel: payload.IsWhitelabel,
+		Cache:        d.cache,
+		RateLimiter:  nil, // Use http-proxy ratelimit functionality
+		BaseContext:  traceCtx,
+	}
+
+	switch payload.InteractionType {
+	case interaction.InteractionTypeApplicationCommand:
+		var interactionData interaction.ApplicationCommandInteraction
+		if err := json.Unmarshal(payload.Event, &interactionData); err != nil {
+			logrus.Warnf("error parsing application payload data: %v", err)
+			return
 		}
 
-		switch payload.InteractionType {
-		case interaction.InteractionTypeApplicationCommand:
-			var interactionData interaction.ApplicationCommandInteraction
-			if err := json.Unmarshal(payload.Event, &interactionData); err != nil {
-				logrus.Warnf("error parsing application payload data: %v", err)
-				return
-			}
+		responseCh := make(chan interaction.ApplicationCommandCallbackData, 1)
+
+		deferDefault, err := executeCommand(traceCtx, d.manager, worker, d.commandManager.GetCommands(), d.commandManager.Middleware(), interactionData, responseCh)
+		if err != nil {
+			marshalled, _ := json.Marshal(payload)
+			logrus.Warnf("error executing payload: %v (payload: %s)", err, string(marshalled))
+			return
+		}
 
-			responseCh := make(chan interaction.ApplicationCommandCallbackData, 1)
+		var flags uint
+		if deferDefault {
+			flags = message.SumFlags(message.FlagEphemeral)
+		}
 
-			deferDefault, err := executeCommand(ctx, worker, commandManager.GetCommands(), interactionData, responseCh)
-			if err != nil {
-				marshalled, _ := json.Marshal(payload)
-				logrus.Warnf("error executing payload: %v (payload: %s)", err, string(marshalled))
-				return
-			}
+		d.respond(ctx, interactionData.Id, interaction.NewResponseAckWithSource(flags))
 
-			var flags uint
-			if deferDefault {
-				flags = message.SumFlags(message.FlagEphemeral)
-			}
+		d.manager.Go(func() {
+			handleApplicationCommandResponseAfterDefer(interactionData, worker, responseCh)
+		})
 
-			res := interaction.NewResponseAckWithSource(flags)
-			ctx.JSON(200, res)
-			ctx.Writer.Flush()
+		prometheus.InteractionTimeToReceive.Observe(calculateTimeToReceive(interactionData.Id).Seconds())
+	case interaction.InteractionTypeMessageComponent:
+		var interactionData interaction.MessageComponentInteraction
+		if err := json.Unmarshal(payload.Event, &interactionData); err != nil {
+			logrus.Warnf("error parsing application payload data: %v", err)
+			return
+		}
 
-			go handleApplicationCommandResponseAfterDefer(interactionData, worker, responseCh)
+		timeToDefer := calculateTimeToDefer(interactionData.Id)
 
-			prometheus.InteractionTimeToReceive.Observe(calculateTimeToReceive(interactionData.Id).Seconds())
-		case interaction.InteractionTypeMessageComponent:
-			var interactionData interaction.MessageComponentInteraction
-			if err := json.Unmarshal(payload.Event, &interactionData); err != nil {
-				logrus.Warnf("error parsing application payload data: %v", err)
-				return
-			}
+		responseCh := make(chan button.Response, 1) // Buffer > 0 is important, or it could hang!
+		btn_manager.HandleInteraction(traceCtx, d.manager, d.buttonManager, worker, interactionData, responseCh)
 
-			timeToDefer := calculateTimeToDefer(interactionData.Id)
+		select {
+		case <-time.After(timeToDefer):
+			d.respond(ctx, interactionData.Id, interaction.NewResponseDeferredMessageUpdate())
+		case data := <-responseCh:
+			d.respond(ctx, interactionData.Id, data.Build())
+		}
 
-			responseCh := make(chan button.Response, 1) // Buffer > 0 is important, or it could hang!
-			btn_manager.HandleInteraction(ctx, buttonManager, worker, interactionData, responseCh)
+		deferredAt := time.Now()
+		d.manager.Go(func() {
+			handleButtonResponseAfterDefer(interactionData.InteractionMetadata, worker, deferredAt, responseCh)
+		})
+
+		prometheus.InteractionTimeToReceive.Observe(calculateTimeToReceive(interactionData.Id).Seconds())
+		prometheus.InteractionTimeToDefer.Observe(timeToDefer.Seconds())
+	case interaction.InteractionTypeApplicationCommandAutoComplete:
+		var interactionData interaction.ApplicationCommandAutoCompleteInteraction
+		if err := json.Unmarshal(payload.Event, &interactionData); err != nil {
+			logrus.Warnf("error parsing application payload data: %v", err)
+			return
+		}
 
-			select {
-			case <-time.After(timeToDefer):
-				res := interaction.NewResponseDeferredMessageUpdate()
-				ctx.JSON(200, res)
-				ctx.Writer.Flush()
-			case data := <-responseCh:
-				ctx.JSON(200, data.Build())
-				ctx.Writer.Flush()
-			}
+		cmd, ok := d.commandManager.GetCommands()[interactionData.Data.Name]
+		if !ok {
+			logrus.Warnf("autocomplete for invalid command: %s", interactionData.Data.Name)
+			return
+		}
 
-			go handleButtonResponseAfterDefer(interactionData.InteractionMetadata, worker, time.Now(), responseCh)
+		options := interactionData.Data.Options
+		for len(options) > 0 && options[0].Value == nil { // Value and Options are mutually exclusive, value is never present on subcommands
+			subCommand := options[0]
 
-			prometheus.InteractionTimeToReceive.Observe(calculateTimeToReceive(interactionData.Id).Seconds())
-			prometheus.InteractionTimeToDefer.Observe(timeToDefer.Seconds())
-		case interaction.InteractionTypeApplicationCommandAutoComplete:
-			var interactionData interaction.ApplicationCommandAutoCompleteInteraction
-			if err := json.Unmarshal(payload.Event, &interactionData); err != nil {
-				logrus.Warnf("error parsing application payload data: %v", err)
-				return
+			var found bool
+			for _, child := range cmd.Properties().Children {
+				if child.Properties().Name == subCommand.Name {
+					cmd = child
+					found = true
+					break
+				}
 			}
 
-			cmd, ok := commandManager.GetCommands()[interactionData.Data.Name]
-			if !ok {
-				logrus.Warnf("autocomplete for invalid command: %s", interactionData.Data.Name)
+			if !found {
+				logrus.Warnf("subcommand %s does not exist for command %s", subCommand.Name, cmd.Properties().Name)
 				return
 			}
 
-			options := interactionData.Data.Options
-			for len(options) > 0 && options[0].Value == nil { // Value and Options are mutually exclusive, value is never present on subcommands
-				subCommand := options[0]
-
-				var found bool
-				for _, child := range cmd.Properties().Children {
-					if child.Properties().Name == subCommand.Name {
-						cmd = child
-						found = true
-						break
-					}
-				}
+			options = subCommand.Options
+		}
 
-				if !found {
-					logrus.Warnf("subcommand %s does not exist for command %s", subCommand.Name, cmd.Properties().Name)
-					return
-				}
+		focused := findFocusedOption(interactionData.Data.Options)
+		if focused == nil {
+			logrus.Warnf("focused option not found")
+			return
+		}
 
-				options = subCommand.Options
+		var handler command.AutoCompleteHandler
+		for _, arg := range cmd.Properties().Arguments {
+			if strings.ToLower(arg.Name) == strings.ToLower(focused.Name) {
+				handler = arg.AutoCompleteHandler
 			}
+		}
 
-			focused := findFocusedOption(interactionData.Data.Options)
-			if focused == nil {
-				logrus.Warnf("focused option not found")
-				return
-			}
+		if handler == nil {
+			logrus.Warnf("autocomplete for argument without handler: %s", focused.Name)
+			return
+		}
 
-			var handler command.AutoCompleteHandler
-			for _, arg := range cmd.Properties().Arguments {
-				if strings.ToLower(arg.Name) == strings.ToLower(focused.Name) {
-					handler = arg.AutoCompleteHandler
-				}
-			}
+		choices := handler(interactionData, fmt.Sprintf("%v", focused.Value))
+		d.respond(ctx, interactionData.Id, interaction.NewApplicationCommandAutoCompleteResultResponse(choices))
 
-			if handler == nil {
-				logrus.Warnf("autocomplete for argument without handler: %s", focused.Name)
-				return
-			}
+	case interaction.InteractionTypeModalSubmit:
+		var interactionData interaction.ModalSubmitInteraction
+		if err := json.Unmarshal(payload.Event, &interactionData); err != nil {
+			logrus.Warnf("error parsing application payload data: %v", err)
+			return
+		}
 
-			choices := handler(interactionData, fmt.Sprintf("%v", focused.Value))
-			res := interaction.NewApplicationCommandAutoCompleteResultResponse(choices)
-			ctx.JSON(200, res)
-			ctx.Writer.Flush()
+		d.respond(ctx, interactionData.Id, interaction.NewResponseDeferredMessageUpdate())
 
-		case interaction.InteractionTypeModalSubmit:
-			var interactionData interaction.ModalSubmitInteraction
-			if err := json.Unmarshal(payload.Event, &interactionData); err != nil {
-				logrus.Warnf("error parsing application payload data: %v", err)
-				return
-			}
+		responseCh := make(chan button.Response, 1)
+		btn_manager.HandleModalInteraction(traceCtx, d.manager, d.buttonManager, worker, interactionData, responseCh)
 
-			ctx.JSON(200, interaction.NewResponseDeferredMessageUpdate())
-			ctx.Writer.Flush()
+		deferredAt := time.Now()
+		d.manager.Go(func() {
+			handleButtonResponseAfterDefer(interactionData.InteractionMetadata, worker, deferredAt, responseCh)
+		})
+	}
+}
 
-			responseCh := make(chan button.Response, 1)
-			btn_manager.HandleModalInteraction(ctx, buttonManager, worker, interactionData, responseCh)
+// respond writes the initial response to the interaction, and caches it if dedup is enabled
+func (d *interactionDispatcher) respond(ctx *gin.Context, interactionId uint64, res any) {
+	ctx.JSON(200, res)
+	ctx.Writer.Flush()
 
-			go handleButtonResponseAfterDefer(interactionData.InteractionMetadata, worker, time.Now(), responseCh)
-		}
+	if d.dedup {
+		storeResponse(interactionId, res)
 	}
 }
 
@@ -0,0 +1,65 @@
+package event
+
+import (
+	"context"
+	"github.com/twmb/franz-go/pkg/kadm"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+// KafkaLagMonitor reports how far the worker's consumer group is behind the end of each partition. The rpc client
+// doesn't expose its Kafka client, so the monitor uses its own.
+type KafkaLagMonitor struct {
+	client *kgo.Client
+	admin  *kadm.Client
+	group  string
+}
+
+type KafkaLag struct {
+	Total  int64                      `json:"total"`
+	Topics map[string]map[int32]int64 `json:"topics"`
+}
+
+func NewKafkaLagMonitor(brokers []string, group string) (*KafkaLagMonitor, error) {
+	client, err := kgo.NewClient(kgo.SeedBrokers(brokers...))
+	if err != nil {
+		return nil, err
+	}
+
+	return &KafkaLagMonitor{
+		client: client,
+		admin:  kadm.NewClient(client),
+		group:  group,
+	}, nil
+}
+
+func (m *KafkaLagMonitor) Lag(ctx context.Context) (KafkaLag, error) {
+	lags, err := m.admin.Lag(ctx, m.group)
+	if err != nil {
+		return KafkaLag{}, err
+	}
+
+	if err := lags.Error(); err != nil {
+		return KafkaLag{}, err
+	}
+
+	groupLag := lags[m.group].Lag
+
+	res := KafkaLag{
+		Total:  groupLag.Total(),
+		Topics: make(map[string]map[int32]int64),
+	}
+
+	for topic, partitions := range groupLag {
+		res.Topics[topic] = make(map[int32]int64)
+
+		for partition, lag := range partitions {
+			res.Topics[topic][partition] = lag.Lag
+		}
+	}
+
+	return res, nil
+}
+
+func (m *KafkaLagMonitor) Close() {
+	m.client.Close()
+}
@@ -5,21 +5,52 @@ import (
 	"github.com/TicketsBot/common/eventforwarding"
 	"github.com/TicketsBot/common/rpc"
 	"github.com/TicketsBot/worker"
+	"github.com/TicketsBot/worker/bot/lifecycle"
+	"github.com/TicketsBot/worker/bot/tracing"
+	"github.com/TicketsBot/worker/config"
 	"github.com/rxdn/gdl/cache"
+	"github.com/rxdn/gdl/gateway/payloads/events"
+	"go.opentelemetry.io/otel/attribute"
 	"go.uber.org/zap"
 )
 
 type KafkaConsumer struct {
-	logger *zap.Logger
-	cache  *cache.PgCache
+	logger   *zap.Logger
+	cache    *cache.PgCache
+	dlq      *DeadLetterQueue
+	recorder *Recorder
+	manager  *lifecycle.Manager
+	executor *keyedExecutor
+	bypass   map[events.EventType]struct{}
 }
 
 var _ rpc.Listener = (*KafkaConsumer)(nil)
 
-func NewKafkaListener(logger *zap.Logger, cache *cache.PgCache) *KafkaConsumer {
+// dlq may be nil, in which case events that fail to process are dropped. recorder may be nil if recording is disabled.
+//
+// Events are handed off to a keyed executor, which runs up to GoroutineLimit events at once while keeping events for
+// the same guild or channel in order. For the order to be preserved, HandleMessage must be called with the consumer's
+// concurrency set to 1.
+func NewKafkaListener(
+	logger *zap.Logger,
+	cache *cache.PgCache,
+	dlq *DeadLetterQueue,
+	recorder *Recorder,
+	manager *lifecycle.Manager,
+) *KafkaConsumer {
 	return &KafkaConsumer{
-		logger: logger,
-		cache:  cache,
+		logger:   logger,
+		cache:    cache,
+		dlq:      dlq,
+		recorder: recorder,
+		manager:  manager,
+		executor: newKeyedExecutor(
+			logger,
+			config.Conf.Kafka.GoroutineLimit,
+			config.Conf.Kafka.OrderedQueueSize,
+			config.Conf.Kafka.MaxQueuedEvents,
+		),
+		bypass: newBypassSet(config.Conf.Kafka.OrderBypassEvents),
 	}
 }
 
@@ -27,13 +58,39 @@ func (k *KafkaConsumer) BuildContext() (context.Context, context.CancelFunc) {
 	return context.WithCancel(context.Background())
 }
 
-func (k *KafkaConsumer) HandleMessage(ctx context.Context, message []byte) {
+func (k *KafkaConsumer) HandleMessage(_ context.Context, message []byte) {
 	var event eventforwarding.Event
 	if err := json.Unmarshal(message, &event); err != nil {
 		k.logger.Error("Failed to unmarshal event", zap.Error(err))
 		return
 	}
 
+	k.recorder.RecordEvent(SourceKafka, event)
+
+	// The message has already been fetched, so it must be processed even if we are shutting down
+	done := k.manager.Add()
+
+	// The context passed to HandleMessage is cancelled as soon as it returns, which is before the event is processed
+	k.executor.submit(orderingKey(event.Event, k.bypass), func() {
+		defer done()
+		k.process(context.Background(), event)
+	})
+}
+
+func (k *KafkaConsumer) process(ctx context.Context, event eventforwarding.Event) {
+	// Kafka delivers at least once, so the same event may be received again after a rebalance
+	if !claimEvent(k.logger, event, SourceKafka) {
+		return
+	}
+
+	// The RPC client only passes on the record value, so the trace context can't be read from the record headers, and
+	// each event starts a new trace
+	ctx, span := tracing.Start(ctx, "kafka.event",
+		attribute.Int64("discord.bot_id", int64(event.BotId)),
+		attribute.Int("discord.shard_id", event.ShardId),
+	)
+	defer span.End()
+
 	workerCtx := &worker.Context{
 		Token:        event.BotToken,
 		BotId:        event.BotId,
@@ -43,7 +100,9 @@ func (k *KafkaConsumer) HandleMessage(ctx context.Context, message []byte) {
 		RateLimiter:  nil, // Use http-proxy ratelimit functionality
 	}
 
-	if err := execute(workerCtx, event.Event); err != nil {
-		k.logger.Error("Failed to handle event", zap.Error(err))
+	if attempts, err := executeWithRetry(ctx, workerCtx, event.Event, SourceKafka); err != nil {
+		tracing.RecordError(span, err)
+		handleFailedEvent(k.logger, k.dlq, event, SourceKafka, attempts, err)
+		releaseEvent(k.logger, event)
 	}
 }
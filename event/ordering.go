@@ -0,0 +1,188 @@
+package event
+
+import (
+	"fmt"
+	"github.com/TicketsBot/worker/bot/metrics/prometheus"
+	"github.com/rxdn/gdl/gateway/payloads"
+	"github.com/rxdn/gdl/gateway/payloads/events"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+// keyedExecutor runs tasks that share a key one at a time, in the order they were submitted, while tasks with
+// different keys run in parallel. Tasks with an empty key are not ordered. submit must not be called concurrently,
+// as the order of concurrent submissions is undefined.
+type keyedExecutor struct {
+	logger    *zap.Logger
+	queueSize int
+
+	mu     sync.Mutex
+	queues map[string]*keyQueue
+
+	workers  chan struct{} // Limits the number of tasks running at once
+	capacity chan struct{} // Limits the number of tasks queued or running at once
+}
+
+type keyQueue struct {
+	tasks   chan func()
+	pending int // Tasks submitted to the queue that haven't finished yet, including those blocked on a full queue
+}
+
+func newKeyedExecutor(logger *zap.Logger, concurrency, queueSize, maxQueued int) *keyedExecutor {
+	return &keyedExecutor{
+		logger:    logger,
+		queueSize: queueSize,
+		queues:    make(map[string]*keyQueue),
+		workers:   make(chan struct{}, concurrency),
+		capacity:  make(chan struct{}, maxQueued),
+	}
+}
+
+// submit queues the task, blocking if either the queue for the key or the executor as a whole is full, which in turn
+// stops the consumer from polling more events.
+func (e *keyedExecutor) submit(key string, task func()) {
+	select {
+	case e.capacity <- struct{}{}:
+	default:
+		prometheus.OrderedBackpressure.WithLabelValues("max_queued").Inc()
+		e.capacity <- struct{}{}
+	}
+
+	prometheus.OrderedQueuedEvents.Inc()
+
+	queuedAt := time.Now()
+	wrapped := func() {
+		defer func() {
+			<-e.capacity
+			prometheus.OrderedQueuedEvents.Dec()
+		}()
+
+		e.workers <- struct{}{}
+		defer func() {
+			<-e.workers
+		}()
+
+		prometheus.OrderedQueueWait.Observe(time.Since(queuedAt).Seconds())
+		e.run(key, task)
+	}
+
+	if key == "" {
+		go wrapped()
+		return
+	}
+
+	e.mu.Lock()
+	queue, ok := e.queues[key]
+	if !ok {
+		queue = &keyQueue{
+			tasks: make(chan func(), e.queueSize),
+		}
+
+		e.queues[key] = queue
+		prometheus.OrderedActiveKeys.Inc()
+
+		go e.drain(key, queue)
+	}
+
+	queue.pending++
+	e.mu.Unlock()
+
+	select {
+	case queue.tasks <- wrapped:
+	default:
+		prometheus.OrderedBackpressure.WithLabelValues("key_queue_full").Inc()
+		queue.tasks <- wrapped
+	}
+}
+
+// drain runs tasks from the queue until it is empty, at which point the queue is removed. Because pending is
+// incremented before a task is sent, the queue can't be removed while a submission to it is in progress.
+func (e *keyedExecutor) drain(key string, queue *keyQueue) {
+	for task := range queue.tasks {
+		task()
+
+		e.mu.Lock()
+		queue.pending--
+
+		if queue.pending == 0 {
+			delete(e.queues, key)
+			e.mu.Unlock()
+
+			prometheus.OrderedActiveKeys.Dec()
+			return
+		}
+
+		e.mu.Unlock()
+	}
+}
+
+// The consumer's worker pool used to recover panics for us, so keep doing so now that events run on our own goroutines
+func (e *keyedExecutor) run(key string, task func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			e.logger.Error("Recovered panic whilst processing event", zap.String("key", key), zap.Any("panic", r))
+		}
+	}()
+
+	task()
+}
+
+// orderingKey returns the key that an event should be serialised under. Message and channel events are keyed by
+// channel, so that a channel being deleted can't race messages sent in it, without a busy guild's messages all being
+// processed one at a time. Other events are keyed by guild. Events in the bypass set, and events that aren't
+// associated with a guild or channel, return an empty key and are processed without ordering.
+func orderingKey(event []byte, bypass map[events.EventType]struct{}) string {
+	var payload payloads.Payload
+	if err := json.Unmarshal(event, &payload); err != nil {
+		return "" // execute will report the error
+	}
+
+	eventType := events.EventType(payload.EventName)
+	if _, ok := bypass[eventType]; ok {
+		return ""
+	}
+
+	var data struct {
+		Id        uint64 `json:"id,string"`
+		GuildId   uint64 `json:"guild_id,string"`
+		ChannelId uint64 `json:"channel_id,string"`
+	}
+
+	if err := json.Unmarshal(payload.Data, &data); err != nil {
+		return ""
+	}
+
+	switch eventType {
+	case events.MESSAGE_CREATE, events.MESSAGE_UPDATE, events.MESSAGE_DELETE, events.MESSAGE_DELETE_BULK,
+		events.MESSAGE_REACTION_ADD, events.MESSAGE_REACTION_REMOVE, events.MESSAGE_REACTION_REMOVE_ALL,
+		events.MESSAGE_REACTION_REMOVE_EMOJI, events.CHANNEL_PINS_UPDATE, events.TYPING_START:
+		if data.ChannelId != 0 {
+			return fmt.Sprintf("channel:%d", data.ChannelId)
+		}
+	case events.CHANNEL_CREATE, events.CHANNEL_UPDATE, events.CHANNEL_DELETE,
+		events.THREAD_CREATE, events.THREAD_UPDATE, events.THREAD_DELETE, events.THREAD_MEMBERS_UPDATE:
+		if data.Id != 0 {
+			return fmt.Sprintf("channel:%d", data.Id)
+		}
+	case events.GUILD_CREATE, events.GUILD_UPDATE, events.GUILD_DELETE:
+		if data.Id != 0 {
+			return fmt.Sprintf("guild:%d", data.Id)
+		}
+	}
+
+	if data.GuildId != 0 {
+		return fmt.Sprintf("guild:%d", data.GuildId)
+	}
+
+	return ""
+}
+
+func newBypassSet(eventTypes []string) map[events.EventType]struct{} {
+	set := make(map[events.EventType]struct{}, len(eventTypes))
+	for _, eventType := range eventTypes {
+		set[events.EventType(eventType)] = struct{}{}
+	}
+
+	return set
+}
@@ -0,0 +1,71 @@
+package event
+
+import (
+	"github.com/rxdn/gdl/gateway/payloads/events"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestKeyedExecutorPreservesOrder(t *testing.T) {
+	executor := newKeyedExecutor(zap.NewNop(), 8, 2, 100)
+
+	var mu sync.Mutex
+	var order []int
+	var wg sync.WaitGroup
+
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		executor.submit("guild:1", func() {
+			defer wg.Done()
+
+			time.Sleep(time.Millisecond)
+
+			mu.Lock()
+			order = append(order, i)
+			mu.Unlock()
+		})
+	}
+
+	wg.Wait()
+
+	for i, v := range order {
+		require.Equal(t, i, v)
+	}
+}
+
+func TestKeyedExecutorRunsKeysInParallel(t *testing.T) {
+	executor := newKeyedExecutor(zap.NewNop(), 2, 1, 100)
+
+	release := make(chan struct{})
+	started := make(chan struct{}, 2)
+
+	for _, key := range []string{"guild:1", "guild:2"} {
+		executor.submit(key, func() {
+			started <- struct{}{}
+			<-release
+		})
+	}
+
+	for i := 0; i < 2; i++ {
+		select {
+		case <-started:
+		case <-time.After(time.Second):
+			t.Fatal("tasks for different keys did not run in parallel")
+		}
+	}
+
+	close(release)
+}
+
+func TestOrderingKey(t *testing.T) {
+	bypass := newBypassSet([]string{string(events.TYPING_START)})
+
+	require.Equal(t, "channel:2", orderingKey([]byte(`{"t":"MESSAGE_CREATE","d":{"id":"3","guild_id":"1","channel_id":"2"}}`), bypass))
+	require.Equal(t, "channel:2", orderingKey([]byte(`{"t":"CHANNEL_DELETE","d":{"id":"2","guild_id":"1"}}`), bypass))
+	require.Equal(t, "guild:1", orderingKey([]byte(`{"t":"GUILD_MEMBER_UPDATE","d":{"guild_id":"1"}}`), bypass))
+	require.Equal(t, "guild:1", orderingKey([]byte(`{"t":"GUILD_CREATE","d":{"id":"1"}}`), bypass))
+	require.Equal(t, "", orderingKey([]byte(`{"t":"TYPING_START","d":{"guild_id":"1","channel_id":"2"}}`), bypass))
+}
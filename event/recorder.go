@@ -0,0 +1,127 @@
+package event
+
+import (
+	"github.com/TicketsBot/common/eventforwarding"
+	jsoniter "github.com/json-iterator/go"
+	"go.uber.org/zap"
+	"os"
+	"sync"
+	"time"
+)
+
+type RecordKind string
+
+const (
+	RecordKindEvent       RecordKind = "event"
+	RecordKindInteraction RecordKind = "interaction"
+)
+
+const redactedValue = "REDACTED"
+
+// Record is a single line of a recording. Exactly one of Event or Interaction is set, depending on Kind.
+type Record struct {
+	Kind        RecordKind                   `json:"kind"`
+	Source      string                       `json:"source"`
+	ReceivedAt  time.Time                    `json:"received_at"`
+	Event       *eventforwarding.Event       `json:"event,omitempty"`
+	Interaction *eventforwarding.Interaction `json:"interaction,omitempty"`
+}
+
+// Recorder writes received events and interactions to a JSONL file, so that they can be fed back through the worker
+// with cmd/replay. Bot tokens and interaction tokens are redacted before anything is written. A nil *Recorder is valid
+// and records nothing, so callers do not need to check whether recording is enabled.
+type Recorder struct {
+	logger *zap.Logger
+	file   *os.File
+	mu     sync.Mutex
+}
+
+func NewRecorder(logger *zap.Logger, path string) (*Recorder, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Recorder{
+		logger: logger,
+		file:   file,
+	}, nil
+}
+
+func (r *Recorder) RecordEvent(source string, event eventforwarding.Event) {
+	if r == nil {
+		return
+	}
+
+	event.BotToken = redactedValue
+
+	r.write(Record{
+		Kind:       RecordKindEvent,
+		Source:     source,
+		ReceivedAt: time.Now(),
+		Event:      &event,
+	})
+}
+
+func (r *Recorder) RecordInteraction(source string, payload eventforwarding.Interaction) {
+	if r == nil {
+		return
+	}
+
+	payload.BotToken = redactedValue
+	payload.Event = redactInteractionToken(payload.Event)
+
+	r.write(Record{
+		Kind:        RecordKindInteraction,
+		Source:      source,
+		ReceivedAt:  time.Now(),
+		Interaction: &payload,
+	})
+}
+
+func (r *Recorder) Close() error {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.file.Close()
+}
+
+func (r *Recorder) write(record Record) {
+	marshalled, err := json.Marshal(record)
+	if err != nil {
+		r.logger.Warn("Failed to marshal record", zap.Error(err))
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := r.file.Write(append(marshalled, '\n')); err != nil {
+		r.logger.Warn("Failed to write record", zap.Error(err))
+	}
+}
+
+// Interaction tokens can be used to send messages as the bot for 15 minutes after the interaction is created
+func redactInteractionToken(data []byte) []byte {
+	var fields map[string]jsoniter.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return data
+	}
+
+	if _, ok := fields["token"]; !ok {
+		return data
+	}
+
+	fields["token"] = jsoniter.RawMessage(`"` + redactedValue + `"`)
+
+	redacted, err := json.Marshal(fields)
+	if err != nil {
+		return data
+	}
+
+	return redacted
+}
@@ -0,0 +1,77 @@
+package event
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/TicketsBot/worker/bot/lifecycle"
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"github.com/rxdn/gdl/cache"
+	"go.uber.org/zap"
+	"net/http"
+	"net/http/httptest"
+)
+
+// Replayer feeds recorded events and interactions back through the same handlers that serve live traffic
+type Replayer struct {
+	eventHandler       gin.HandlerFunc
+	interactionHandler gin.HandlerFunc
+	token              string
+}
+
+// token replaces the redacted bot token in each record, and should be accepted by whichever REST server the replay
+// is running against.
+func NewReplayer(logger *zap.Logger, redis *redis.Client, cache *cache.PgCache, token string) *Replayer {
+	return &Replayer{
+		// Dedup is disabled, so that a recording can be replayed more than once against the same Redis instance
+		eventHandler:       eventHandler(logger, cache, nil, nil, false),
+		interactionHandler: interactionHandler(nil, newInteractionDispatcher(cache, lifecycle.NewManager(), false)),
+		token:              token,
+	}
+}
+
+// Replay passes the record to the relevant handler, and returns the status code and body of the HTTP response that
+// would have been sent back to the proxy. Work started in the background by the handler, such as deferred interaction
+// responses, may still be running when Replay returns.
+func (r *Replayer) Replay(record Record) (int, []byte, error) {
+	var path string
+	var handler gin.HandlerFunc
+	var payload any
+
+	switch record.Kind {
+	case RecordKindEvent:
+		if record.Event == nil {
+			return 0, nil, fmt.Errorf("event record is missing event")
+		}
+
+		event := *record.Event
+		event.BotToken = r.token
+
+		path, handler, payload = "/event", r.eventHandler, event
+	case RecordKindInteraction:
+		if record.Interaction == nil {
+			return 0, nil, fmt.Errorf("interaction record is missing interaction")
+		}
+
+		interaction := *record.Interaction
+		interaction.BotToken = r.token
+
+		path, handler, payload = "/interaction", r.interactionHandler, interaction
+	default:
+		return 0, nil, fmt.Errorf("unknown record kind %s", record.Kind)
+	}
+
+	marshalled, err := json.Marshal(payload)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	w := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(w)
+	ctx.Request = httptest.NewRequest(http.MethodPost, path, bytes.NewReader(marshalled))
+	ctx.Request.Header.Set("Content-Type", "application/json")
+
+	handler(ctx)
+
+	return w.Code, w.Body.Bytes(), nil
+}
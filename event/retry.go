@@ -0,0 +1,111 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"github.com/TicketsBot/worker"
+	"github.com/TicketsBot/worker/bot/metrics/prometheus"
+	"github.com/TicketsBot/worker/config"
+	"github.com/jackc/pgconn"
+	"github.com/rxdn/gdl/rest/request"
+	"io"
+	"net"
+	"time"
+)
+
+// permanentError marks a failure that will never succeed if the event is retried, e.g. a malformed payload.
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e permanentError) Unwrap() error {
+	return e.err
+}
+
+func newPermanentError(err error) error {
+	return permanentError{err: err}
+}
+
+// executeWithRetry runs the event through execute, retrying transient failures with exponential backoff. It returns
+// the number of attempts made, and the error from the final attempt, if any.
+func executeWithRetry(ctx context.Context, c *worker.Context, event []byte, source string) (int, error) {
+	maxAttempts := max(config.Conf.EventRetry.MaxAttempts, 1)
+	backoff := config.Conf.EventRetry.InitialBackoff
+
+	var attempt int
+	for {
+		attempt++
+
+		err := execute(ctx, c, event)
+		if err == nil || attempt >= maxAttempts || !isRetryable(err) {
+			return attempt, err
+		}
+
+		prometheus.EventRetries.WithLabelValues(source).Inc()
+
+		select {
+		case <-ctx.Done():
+			return attempt, err
+		case <-time.After(backoff):
+		}
+
+		backoff = min(backoff*2, config.Conf.EventRetry.MaxBackoff)
+	}
+}
+
+// isRetryable reports whether err is the result of a transient failure, such as a dropped database connection or a
+// Discord outage. Unrecognised errors are treated as permanent, as listeners may have already performed side effects
+// before failing, and so retrying them blindly risks duplicate messages.
+func isRetryable(err error) bool {
+	var permanent permanentError
+	if errors.As(err, &permanent) {
+		return false
+	}
+
+	// Context was cancelled by us, e.g. during shutdown
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var restError request.RestError
+	if errors.As(err, &restError) {
+		return restError.IsServerError() || restError.StatusCode == 429
+	}
+
+	var pgError *pgconn.PgError
+	if errors.As(err, &pgError) {
+		return isRetryablePgCode(pgError.Code)
+	}
+
+	if pgconn.Timeout(err) || pgconn.SafeToRetry(err) {
+		return true
+	}
+
+	var netError net.Error
+	return errors.As(err, &netError)
+}
+
+// See https://www.postgresql.org/docs/current/errcodes-appendix.html
+func isRetryablePgCode(code string) bool {
+	if len(code) < 2 {
+		return false
+	}
+
+	switch code[:2] {
+	case "08", // Connection exception
+		"40", // Transaction rollback, e.g. serialization failure or deadlock
+		"53", // Insufficient resources
+		"57": // Operator intervention, e.g. admin shutdown
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,53 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgconn"
+	"github.com/rxdn/gdl/rest/request"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRetryableServerError(t *testing.T) {
+	err := request.RestError{StatusCode: 502}
+	require.True(t, isRetryable(err))
+}
+
+func TestRetryableRateLimit(t *testing.T) {
+	err := fmt.Errorf("failed to send message: %w", request.RestError{StatusCode: 429})
+	require.True(t, isRetryable(err))
+}
+
+func TestPermanentClientError(t *testing.T) {
+	err := request.RestError{StatusCode: 403}
+	require.False(t, isRetryable(err))
+}
+
+func TestRetryablePgConnectionError(t *testing.T) {
+	err := &pgconn.PgError{Code: "08006"}
+	require.True(t, isRetryable(err))
+}
+
+func TestPermanentPgConstraintViolation(t *testing.T) {
+	err := &pgconn.PgError{Code: "23505"}
+	require.False(t, isRetryable(err))
+}
+
+func TestRetryableDeadlineExceeded(t *testing.T) {
+	require.True(t, isRetryable(context.DeadlineExceeded))
+}
+
+func TestPermanentCancelled(t *testing.T) {
+	require.False(t, isRetryable(context.Canceled))
+}
+
+func TestPermanentWrapped(t *testing.T) {
+	err := newPermanentError(context.DeadlineExceeded)
+	require.False(t, isRetryable(err))
+}
+
+func TestUnknownErrorIsPermanent(t *testing.T) {
+	require.False(t, isRetryable(errors.New("something went wrong")))
+}
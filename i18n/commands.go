@@ -0,0 +1,46 @@
+package i18n
+
+import "strings"
+
+// Command names and argument names and descriptions are not MessageIds in the code, so their translations are keyed
+// by the full name of the command, e.g. "claim self". Spaces are kept as-is, as the locale files use dots for nesting.
+
+// CommandNameId returns the ID of the translated name of a command or subcommand
+func CommandNameId(command string) MessageId {
+	return MessageId("command_names." + command)
+}
+
+// ArgumentNameId returns the ID of the translated name of a command's argument
+func ArgumentNameId(command, argument string) MessageId {
+	return MessageId("command_options." + command + "." + argument + ".name")
+}
+
+// ArgumentDescriptionId returns the ID of the translated description of a command's argument
+func ArgumentDescriptionId(command, argument string) MessageId {
+	return MessageId("command_options." + command + "." + argument + ".description")
+}
+
+// GetDiscordLocalizations returns the translations of the message, keyed by Discord locale, for use in command
+// registration. Locales that Discord does not support, or that have no translation of their own, are left out rather
+// than falling back to English, which Discord uses by default.
+func GetDiscordLocalizations(id MessageId) map[string]string {
+	var localizations map[string]string
+	for _, locale := range Locales {
+		if locale == LocaleEnglish || locale.DiscordLocale == nil {
+			continue
+		}
+
+		value := strings.TrimSpace(locale.Messages[id])
+		if value == "" {
+			continue
+		}
+
+		if localizations == nil {
+			localizations = make(map[string]string)
+		}
+
+		localizations[*locale.DiscordLocale] = value
+	}
+
+	return localizations
+}
@@ -0,0 +1,29 @@
+package i18n
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGetDiscordLocalizations(t *testing.T) {
+	german, esperanto := MappedByIsoShortCode["de"], MappedByIsoShortCode["eo"]
+	if german == nil {
+		SeedIndices()
+		german, esperanto = MappedByIsoShortCode["de"], MappedByIsoShortCode["eo"]
+	}
+
+	prevEnglish, prevGerman, prevEsperanto := LocaleEnglish.Messages, german.Messages, esperanto.Messages
+	t.Cleanup(func() {
+		LocaleEnglish.Messages, german.Messages, esperanto.Messages = prevEnglish, prevGerman, prevEsperanto
+	})
+
+	id := CommandNameId("claim self")
+	require.Equal(t, MessageId("command_names.claim self"), id)
+
+	LocaleEnglish.Messages = map[MessageId]string{id: "self"}
+	german.Messages = map[MessageId]string{id: " selbst "}
+	esperanto.Messages = map[MessageId]string{id: "mem"} // Not supported by Discord
+
+	require.Equal(t, map[string]string{"de": "selbst"}, GetDiscordLocalizations(id))
+	require.Nil(t, GetDiscordLocalizations(ArgumentNameId("claim self", "user")))
+}
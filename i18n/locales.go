@@ -1,5 +1,7 @@
 package i18n
 
+import "sync/atomic"
+
 type Locale struct {
 	IsoShortCode  string
 	IsoLongCode   string
@@ -370,10 +372,19 @@ func SeedIndices() {
 	}
 }
 
+var loaded atomic.Bool
+
 func Init() {
 	SeedIndices()
 	LoadMessages()
 	SeedCoverage()
+
+	loaded.Store(true)
+}
+
+// Loaded returns whether Init has finished loading the locale files
+func Loaded() bool {
+	return loaded.Load()
 }
 
 func ptr[T any](t T) *T {
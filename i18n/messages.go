@@ -18,6 +18,7 @@ var (
 	Reason    MessageId = "generic.reason"
 	ClickHere MessageId = "generic.click_here"
 	Confirm   MessageId = "generic.confirm"
+	Cancel    MessageId = "generic.cancel"
 	Website   MessageId = "generic.website"
 
 	TitlePremiumOnly       MessageId = "generic.title.premium_only"
@@ -51,6 +52,17 @@ var (
 	TitlePanelSwitched     MessageId = "generic.title.panel_switched"
 	TitleJumpToTop         MessageId = "generic.title.jump_to_top"
 	TitleReopened          MessageId = "generic.title.reopened"
+	TitlePriority          MessageId = "generic.title.priority"
+	TitleSlaWarning        MessageId = "generic.title.sla_warning"
+	TitleSlaBreached       MessageId = "generic.title.sla_breached"
+	TitleMerge             MessageId = "generic.title.merge"
+	TitleSnooze            MessageId = "generic.title.snooze"
+	TitleUnsnoozed         MessageId = "generic.title.unsnoozed"
+	TitleClaimedBy         MessageId = "generic.title.claimed_by"
+	TitleCloseAll          MessageId = "generic.title.close_all"
+	TitleReminder          MessageId = "generic.title.reminder"
+	TitlePermissions       MessageId = "generic.title.permissions"
+	TitleCooldown          MessageId = "generic.title.cooldown"
 
 	MessageAbout   MessageId = "commands.about"
 	MessagePremium MessageId = "commands.premium"
@@ -108,9 +120,17 @@ var (
 	MessageAddSupportSuccess   MessageId = "commands.addsupport.success"
 	MessageAddSupportEveryone  MessageId = "commands.addsupport.everyone"
 
-	MessageAddNoMembers    MessageId = "commands.add.no_members"
-	MessageAddNoPermission MessageId = "commands.add.no_permission"
-	MessageAddSuccess      MessageId = "commands.add.success"
+	MessageAddNoMembers          MessageId = "commands.add.no_members"
+	MessageAddNoPermission       MessageId = "commands.add.no_permission"
+	MessageAddSuccess            MessageId = "commands.add.success"
+	MessageAddRoleSuccess        MessageId = "commands.add.role_success"
+	MessageAddTemporarySuccess   MessageId = "commands.add.temporary_success"
+	MessageAddInvalidDuration    MessageId = "commands.add.invalid_duration"
+	MessageAddDurationTooLong    MessageId = "commands.add.duration_too_long"
+	MessageAddRoleNoPermission   MessageId = "commands.add.role_no_permission"
+	MessageAddRoleEveryone       MessageId = "commands.add.role_everyone"
+	MessageAddRoleTooManyMembers MessageId = "commands.add.role_too_many_members"
+	MessageAddExpired            MessageId = "commands.add.expired"
 
 	MessageBlacklisted         MessageId = "generic.error.blacklisted"
 	MessageBlacklistNoMembers  MessageId = "commands.blacklist.no_members"
@@ -127,6 +147,11 @@ var (
 	MessageClaimNoPermission MessageId = "commands.claim.no_permission"
 	MessageClaimThread       MessageId = "commands.claim.thread"
 
+	MessageCoClaimAdded          MessageId = "commands.claim.co_claim_added"
+	MessageCoClaimRemoved        MessageId = "commands.claim.co_claim_removed"
+	MessageCoClaimAlreadyClaimed MessageId = "commands.claim.already_claimed"
+	MessageCoClaimNotCoClaimer   MessageId = "commands.claim.not_co_claimer"
+
 	MessagePanel MessageId = "commands.panel"
 
 	MessagePremiumAbout                          MessageId = "commands.premium.about"
@@ -176,6 +201,72 @@ var (
 	MessageRenameTooLong     MessageId = "commands.rename.too_long"
 	MessageRenameRatelimited MessageId = "commands.rename.ratelimited"
 
+	MessageMergeHistoryTitle MessageId = "commands.merge.history_title"
+	MessageMergeInvalid      MessageId = "commands.merge.invalid"
+	MessageMergeNoPermission MessageId = "commands.merge.no_permission"
+	MessageMergeSame         MessageId = "commands.merge.same"
+	MessageMergeSuccess      MessageId = "commands.merge.success"
+
+	MessagePriorityInvalid MessageId = "commands.priority.invalid"
+	MessagePrioritySuccess MessageId = "commands.priority.success"
+
+	MessageCloseAllInvalidPanel    MessageId = "commands.closeall.invalid_panel"
+	MessageCloseAllInvalidDuration MessageId = "commands.closeall.invalid_duration"
+	MessageCloseAllNone            MessageId = "commands.closeall.none"
+	MessageCloseAllPreview         MessageId = "commands.closeall.preview"
+	MessageCloseAllExpired         MessageId = "commands.closeall.expired"
+	MessageCloseAllCancelled       MessageId = "commands.closeall.cancelled"
+	MessageCloseAllStarted         MessageId = "commands.closeall.started"
+	MessageCloseAllProgress        MessageId = "commands.closeall.progress"
+	MessageCloseAllComplete        MessageId = "commands.closeall.complete"
+	MessageCloseAllCompleteSelf    MessageId = "commands.closeall.complete_self"
+	MessageCloseAllInterrupted     MessageId = "commands.closeall.interrupted"
+
+	MessageSnoozeInvalidDuration MessageId = "commands.snooze.invalid_duration"
+	MessageSnoozeTooLong         MessageId = "commands.snooze.too_long"
+	MessageSnoozeSuccess         MessageId = "commands.snooze.success"
+	MessageUnsnoozed             MessageId = "snooze.unsnoozed"
+	MessageUnsnoozedReason       MessageId = "snooze.unsnoozed_reason"
+
+	MessageRemindInvalidDuration MessageId = "commands.remind.invalid_duration"
+	MessageRemindTooLong         MessageId = "commands.remind.too_long"
+	MessageRemindMessageTooLong  MessageId = "commands.remind.message_too_long"
+	MessageRemindLimitReached    MessageId = "commands.remind.limit_reached"
+	MessageRemindSuccess         MessageId = "commands.remind.success"
+	MessageRemindList            MessageId = "commands.remind.list"
+	MessageRemindListEmpty       MessageId = "commands.remind.list_empty"
+	MessageRemindNotFound        MessageId = "commands.remind.not_found"
+	MessageRemindCancelled       MessageId = "commands.remind.cancelled"
+	MessageReminder              MessageId = "remind.reminder"
+
+	MessagePermissionsInvalidCommand MessageId = "commands.permissions.invalid_command"
+	MessagePermissionsInvalidTarget  MessageId = "commands.permissions.invalid_target"
+	MessagePermissionsLimitReached   MessageId = "commands.permissions.limit_reached"
+	MessagePermissionsAllowed        MessageId = "commands.permissions.allowed"
+	MessagePermissionsDenied         MessageId = "commands.permissions.denied"
+	MessagePermissionsView           MessageId = "commands.permissions.view"
+	MessagePermissionsViewEmpty      MessageId = "commands.permissions.view_empty"
+	MessagePermissionsReset          MessageId = "commands.permissions.reset"
+	MessagePermissionsResetTarget    MessageId = "commands.permissions.reset_target"
+	MessagePermissionsNotFound       MessageId = "commands.permissions.not_found"
+
+	MessageCooldown              MessageId = "commands.cooldown"
+	MessageCooldownInvalidUses   MessageId = "commands.cooldown.invalid_uses"
+	MessageCooldownInvalidWindow MessageId = "commands.cooldown.invalid_window"
+	MessageCooldownSet           MessageId = "commands.cooldown.set"
+	MessageCooldownDisabled      MessageId = "commands.cooldown.disabled"
+	MessageCooldownReset         MessageId = "commands.cooldown.reset"
+	MessageCooldownNotOverridden MessageId = "commands.cooldown.not_overridden"
+	MessageCooldownViewDefault   MessageId = "commands.cooldown.view_default"
+	MessageCooldownViewOverride  MessageId = "commands.cooldown.view_override"
+	MessageCooldownViewNone      MessageId = "commands.cooldown.view_none"
+	MessageCooldownViewDisabled  MessageId = "commands.cooldown.view_disabled"
+
+	MessageSlaWarningFirstResponse MessageId = "sla.warning.first_response"
+	MessageSlaWarningResolution    MessageId = "sla.warning.resolution"
+	MessageSlaBreachFirstResponse  MessageId = "sla.breach.first_response"
+	MessageSlaBreachResolution     MessageId = "sla.breach.resolution"
+
 	MessageNotClaimed            MessageId = "commands.unclaim.not_claimed"
 	MessageOnlyClaimerCanUnclaim MessageId = "commands.unclaim.not_claimer"
 	MessageUnclaimed             MessageId = "commands.unclaim.success"
@@ -199,6 +290,7 @@ var (
 	MessageCloseRequestDenied       MessageId = "commands.close_request.denied"
 	MessageCloseRequestAccept       MessageId = "commands.close_request.accept"
 	MessageCloseRequestDeny         MessageId = "commands.close_request.deny"
+	MessageCloseRequestInvalidDelay MessageId = "commands.close_request.invalid_delay"
 
 	MessageSwitchPanelInvalidPanel MessageId = "commands.switch_panel.invalid_panel"
 	MessageSwitchPanelSuccess      MessageId = "commands.switch_panel.success"
@@ -260,6 +352,31 @@ var (
 	SetupThreadsSuccess                 MessageId = "setup.threads.success"
 	SetupThreadsDisabled                MessageId = "setup.threads.disabled"
 
+	SetupPriorityInvalid         MessageId = "setup.priority.invalid"
+	SetupPriorityInvalidCategory MessageId = "setup.priority.invalid_category"
+	SetupPrioritySuccess         MessageId = "setup.priority.success"
+	SetupPriorityRemoved         MessageId = "setup.priority.removed"
+
+	SetupPanelPriorityInvalidPanel MessageId = "setup.panelpriority.invalid_panel"
+	SetupPanelPriorityInvalidInput MessageId = "setup.panelpriority.invalid_input"
+	SetupPanelPrioritySuccess      MessageId = "setup.panelpriority.success"
+	SetupPanelPrioritySuccessInput MessageId = "setup.panelpriority.success_input"
+	SetupPanelPriorityRemoved      MessageId = "setup.panelpriority.removed"
+
+	SetupSlaInvalidPanel MessageId = "setup.sla.invalid_panel"
+	SetupSlaInvalidTime  MessageId = "setup.sla.invalid_time"
+	SetupSlaSuccess      MessageId = "setup.sla.success"
+	SetupSlaRemoved      MessageId = "setup.sla.removed"
+
+	SetupSnoozeInvalidCategory MessageId = "setup.snooze.invalid_category"
+	SetupSnoozeSuccess         MessageId = "setup.snooze.success"
+	SetupSnoozeRemoved         MessageId = "setup.snooze.removed"
+
+	SetupAutoAssignInvalidPanel    MessageId = "setup.autoassign.invalid_panel"
+	SetupAutoAssignInvalidStrategy MessageId = "setup.autoassign.invalid_strategy"
+	SetupAutoAssignSuccess         MessageId = "setup.autoassign.success"
+	SetupAutoAssignRemoved         MessageId = "setup.autoassign.removed"
+
 	MessageOwnerIsAlreadyAdmin MessageId = "commands.addadmin.owner"
 	MessageHelpInvite          MessageId = "help.invite"
 	MessageInvite              MessageId = "commands.invite"
@@ -295,13 +412,32 @@ var (
 	HelpTag                MessageId = "help.tag"
 	HelpAdd                MessageId = "help.add"
 	HelpClaim              MessageId = "help.claim"
+	HelpClaimAdd           MessageId = "help.claim.add"
+	HelpClaimRemove        MessageId = "help.claim.remove"
 	HelpClose              MessageId = "help.close"
 	HelpCloseRequest       MessageId = "help.close_request"
+	HelpCloseAll           MessageId = "help.closeall"
 	HelpNotes              MessageId = "help.notes"
 	HelpOpen               MessageId = "help.open"
 	HelpRemove             MessageId = "help.remove"
 	HelpRename             MessageId = "help.rename"
+	HelpPriority           MessageId = "help.priority"
+	HelpMerge              MessageId = "help.merge"
+	HelpSnooze             MessageId = "help.snooze"
+	HelpRemind             MessageId = "help.remind"
+	HelpRemindSet          MessageId = "help.remind.set"
+	HelpRemindList         MessageId = "help.remind.list"
 	HelpReopen             MessageId = "help.reopen"
+	HelpPermissions        MessageId = "help.permissions"
+	HelpPermissionsCommand MessageId = "help.permissions.command"
+	HelpPermissionsView    MessageId = "help.permissions.command.view"
+	HelpPermissionsAllow   MessageId = "help.permissions.command.allow"
+	HelpPermissionsDeny    MessageId = "help.permissions.command.deny"
+	HelpPermissionsReset   MessageId = "help.permissions.command.reset"
+	HelpCooldown           MessageId = "help.cooldown"
+	HelpCooldownView       MessageId = "help.cooldown.view"
+	HelpCooldownSet        MessageId = "help.cooldown.set"
+	HelpCooldownReset      MessageId = "help.cooldown.reset"
 	HelpTransfer           MessageId = "help.transfer"
 	HelpUnclaim            MessageId = "help.unclaim"
 	HelpHelp               MessageId = "help.help"
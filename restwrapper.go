@@ -1,7 +1,6 @@
 package worker
 
 import (
-	"context"
 	"errors"
 	"github.com/rxdn/gdl/cache"
 	"github.com/rxdn/gdl/objects/auditlog"
@@ -19,9 +18,12 @@ import (
 )
 
 func (ctx *Context) GetChannel(channelId uint64) (channel.Channel, error) {
+	spanCtx, span := ctx.startSpan("GetChannel")
+	defer span.End()
+
 	shouldCache := ctx.Cache.Options().Channels
 	if shouldCache {
-		cached, err := ctx.Cache.GetChannel(context.Background(), channelId)
+		cached, err := ctx.Cache.GetChannel(spanCtx, channelId)
 		if err == nil {
 			return cached, nil
 		} else if !errors.Is(err, cache.ErrNotFound) {
@@ -29,35 +31,47 @@ func (ctx *Context) GetChannel(channelId uint64) (channel.Channel, error) {
 		} // else, continue
 	}
 
-	channel, err := rest.GetChannel(context.Background(), ctx.Token, ctx.RateLimiter, channelId)
+	channel, err := rest.GetChannel(spanCtx, ctx.Token, ctx.RateLimiter, channelId)
 
 	if shouldCache && err == nil {
-		go ctx.Cache.StoreChannel(context.Background(), channel)
+		go ctx.Cache.StoreChannel(spanCtx, channel)
 	}
 
 	return channel, err
 }
 
 func (ctx *Context) ModifyChannel(channelId uint64, data rest.ModifyChannelData) (channel.Channel, error) {
-	channel, err := rest.ModifyChannel(context.Background(), ctx.Token, ctx.RateLimiter, channelId, data)
+	spanCtx, span := ctx.startSpan("ModifyChannel")
+	defer span.End()
+
+	channel, err := rest.ModifyChannel(spanCtx, ctx.Token, ctx.RateLimiter, channelId, data)
 
 	if ctx.Cache.Options().Channels && err != nil {
-		go ctx.Cache.StoreChannel(context.Background(), channel)
+		go ctx.Cache.StoreChannel(spanCtx, channel)
 	}
 
 	return channel, err
 }
 
 func (ctx *Context) DeleteChannel(channelId uint64) (channel.Channel, error) {
-	return rest.DeleteChannel(context.Background(), ctx.Token, ctx.RateLimiter, channelId)
+	spanCtx, span := ctx.startSpan("DeleteChannel")
+	defer span.End()
+
+	return rest.DeleteChannel(spanCtx, ctx.Token, ctx.RateLimiter, channelId)
 }
 
 func (ctx *Context) GetChannelMessages(channelId uint64, options rest.GetChannelMessagesData) ([]message.Message, error) {
-	return rest.GetChannelMessages(context.Background(), ctx.Token, ctx.RateLimiter, channelId, options)
+	spanCtx, span := ctx.startSpan("GetChannelMessages")
+	defer span.End()
+
+	return rest.GetChannelMessages(spanCtx, ctx.Token, ctx.RateLimiter, channelId, options)
 }
 
 func (ctx *Context) GetChannelMessage(channelId, messageId uint64) (message.Message, error) {
-	return rest.GetChannelMessage(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId)
+	spanCtx, span := ctx.startSpan("GetChannelMessage")
+	defer span.End()
+
+	return rest.GetChannelMessage(spanCtx, ctx.Token, ctx.RateLimiter, channelId, messageId)
 }
 
 func (ctx *Context) CreateMessage(channelId uint64, content string) (message.Message, error) {
@@ -87,136 +101,232 @@ func (ctx *Context) CreateMessageEmbedReply(channelId uint64, e *embed.Embed, re
 }
 
 func (ctx *Context) CreateMessageComplex(channelId uint64, data rest.CreateMessageData) (message.Message, error) {
-	return rest.CreateMessage(context.Background(), ctx.Token, ctx.RateLimiter, channelId, data)
+	spanCtx, span := ctx.startSpan("CreateMessageComplex")
+	defer span.End()
+
+	return rest.CreateMessage(spanCtx, ctx.Token, ctx.RateLimiter, channelId, data)
 }
 
 func (ctx *Context) CreateReaction(channelId, messageId uint64, emoji string) error {
-	return rest.CreateReaction(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId, emoji)
+	spanCtx, span := ctx.startSpan("CreateReaction")
+	defer span.End()
+
+	return rest.CreateReaction(spanCtx, ctx.Token, ctx.RateLimiter, channelId, messageId, emoji)
 }
 
 func (ctx *Context) DeleteOwnReaction(channelId, messageId uint64, emoji string) error {
-	return rest.DeleteOwnReaction(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId, emoji)
+	spanCtx, span := ctx.startSpan("DeleteOwnReaction")
+	defer span.End()
+
+	return rest.DeleteOwnReaction(spanCtx, ctx.Token, ctx.RateLimiter, channelId, messageId, emoji)
 }
 
 func (ctx *Context) DeleteUserReaction(channelId, messageId, userId uint64, emoji string) error {
-	return rest.DeleteUserReaction(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId, userId, emoji)
+	spanCtx, span := ctx.startSpan("DeleteUserReaction")
+	defer span.End()
+
+	return rest.DeleteUserReaction(spanCtx, ctx.Token, ctx.RateLimiter, channelId, messageId, userId, emoji)
 }
 
 func (ctx *Context) GetReactions(channelId, messageId uint64, emoji string, options rest.GetReactionsData) ([]user.User, error) {
-	return rest.GetReactions(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId, emoji, options)
+	spanCtx, span := ctx.startSpan("GetReactions")
+	defer span.End()
+
+	return rest.GetReactions(spanCtx, ctx.Token, ctx.RateLimiter, channelId, messageId, emoji, options)
 }
 
 func (ctx *Context) DeleteAllReactions(channelId, messageId uint64) error {
-	return rest.DeleteAllReactions(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId)
+	spanCtx, span := ctx.startSpan("DeleteAllReactions")
+	defer span.End()
+
+	return rest.DeleteAllReactions(spanCtx, ctx.Token, ctx.RateLimiter, channelId, messageId)
 }
 
 func (ctx *Context) DeleteAllReactionsEmoji(channelId, messageId uint64, emoji string) error {
-	return rest.DeleteAllReactionsEmoji(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId, emoji)
+	spanCtx, span := ctx.startSpan("DeleteAllReactionsEmoji")
+	defer span.End()
+
+	return rest.DeleteAllReactionsEmoji(spanCtx, ctx.Token, ctx.RateLimiter, channelId, messageId, emoji)
 }
 
 func (ctx *Context) EditMessage(channelId, messageId uint64, data rest.EditMessageData) (message.Message, error) {
-	return rest.EditMessage(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId, data)
+	spanCtx, span := ctx.startSpan("EditMessage")
+	defer span.End()
+
+	return rest.EditMessage(spanCtx, ctx.Token, ctx.RateLimiter, channelId, messageId, data)
 }
 
 func (ctx *Context) DeleteMessage(channelId, messageId uint64) error {
-	return rest.DeleteMessage(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId)
+	spanCtx, span := ctx.startSpan("DeleteMessage")
+	defer span.End()
+
+	return rest.DeleteMessage(spanCtx, ctx.Token, ctx.RateLimiter, channelId, messageId)
 }
 
 func (ctx *Context) BulkDeleteMessages(channelId uint64, messages []uint64) error {
-	return rest.BulkDeleteMessages(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messages)
+	spanCtx, span := ctx.startSpan("BulkDeleteMessages")
+	defer span.End()
+
+	return rest.BulkDeleteMessages(spanCtx, ctx.Token, ctx.RateLimiter, channelId, messages)
 }
 
 func (ctx *Context) EditChannelPermissions(channelId uint64, updated channel.PermissionOverwrite) error {
-	return rest.EditChannelPermissions(context.Background(), ctx.Token, ctx.RateLimiter, channelId, updated)
+	spanCtx, span := ctx.startSpan("EditChannelPermissions")
+	defer span.End()
+
+	return rest.EditChannelPermissions(spanCtx, ctx.Token, ctx.RateLimiter, channelId, updated)
 }
 
 func (ctx *Context) GetChannelInvites(channelId uint64) ([]invite.InviteMetadata, error) {
-	return rest.GetChannelInvites(context.Background(), ctx.Token, ctx.RateLimiter, channelId)
+	spanCtx, span := ctx.startSpan("GetChannelInvites")
+	defer span.End()
+
+	return rest.GetChannelInvites(spanCtx, ctx.Token, ctx.RateLimiter, channelId)
 }
 
 func (ctx *Context) CreateChannelInvite(channelId uint64, data rest.CreateInviteData) (invite.Invite, error) {
-	return rest.CreateChannelInvite(context.Background(), ctx.Token, ctx.RateLimiter, channelId, data)
+	spanCtx, span := ctx.startSpan("CreateChannelInvite")
+	defer span.End()
+
+	return rest.CreateChannelInvite(spanCtx, ctx.Token, ctx.RateLimiter, channelId, data)
 }
 
 func (ctx *Context) DeleteChannelPermissions(channelId, overwriteId uint64) error {
-	return rest.DeleteChannelPermissions(context.Background(), ctx.Token, ctx.RateLimiter, channelId, overwriteId)
+	spanCtx, span := ctx.startSpan("DeleteChannelPermissions")
+	defer span.End()
+
+	return rest.DeleteChannelPermissions(spanCtx, ctx.Token, ctx.RateLimiter, channelId, overwriteId)
 }
 
 func (ctx *Context) TriggerTypingIndicator(channelId uint64) error {
-	return rest.TriggerTypingIndicator(context.Background(), ctx.Token, ctx.RateLimiter, channelId)
+	spanCtx, span := ctx.startSpan("TriggerTypingIndicator")
+	defer span.End()
+
+	return rest.TriggerTypingIndicator(spanCtx, ctx.Token, ctx.RateLimiter, channelId)
 }
 
 func (ctx *Context) GetPinnedMessages(channelId uint64) ([]message.Message, error) {
-	return rest.GetPinnedMessages(context.Background(), ctx.Token, ctx.RateLimiter, channelId)
+	spanCtx, span := ctx.startSpan("GetPinnedMessages")
+	defer span.End()
+
+	return rest.GetPinnedMessages(spanCtx, ctx.Token, ctx.RateLimiter, channelId)
 }
 
 func (ctx *Context) AddPinnedChannelMessage(channelId, messageId uint64) error {
-	return rest.AddPinnedChannelMessage(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId)
+	spanCtx, span := ctx.startSpan("AddPinnedChannelMessage")
+	defer span.End()
+
+	return rest.AddPinnedChannelMessage(spanCtx, ctx.Token, ctx.RateLimiter, channelId, messageId)
 }
 
 func (ctx *Context) DeletePinnedChannelMessage(channelId, messageId uint64) error {
-	return rest.DeletePinnedChannelMessage(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId)
+	spanCtx, span := ctx.startSpan("DeletePinnedChannelMessage")
+	defer span.End()
+
+	return rest.DeletePinnedChannelMessage(spanCtx, ctx.Token, ctx.RateLimiter, channelId, messageId)
 }
 
 func (ctx *Context) JoinThread(channelId uint64) error {
-	return rest.JoinThread(context.Background(), ctx.Token, ctx.RateLimiter, channelId)
+	spanCtx, span := ctx.startSpan("JoinThread")
+	defer span.End()
+
+	return rest.JoinThread(spanCtx, ctx.Token, ctx.RateLimiter, channelId)
 }
 
 func (ctx *Context) AddThreadMember(channelId, userId uint64) error {
-	return rest.AddThreadMember(context.Background(), ctx.Token, ctx.RateLimiter, channelId, userId)
+	spanCtx, span := ctx.startSpan("AddThreadMember")
+	defer span.End()
+
+	return rest.AddThreadMember(spanCtx, ctx.Token, ctx.RateLimiter, channelId, userId)
 }
 
 func (ctx *Context) LeaveThread(channelId uint64) error {
-	return rest.LeaveThread(context.Background(), ctx.Token, ctx.RateLimiter, channelId)
+	spanCtx, span := ctx.startSpan("LeaveThread")
+	defer span.End()
+
+	return rest.LeaveThread(spanCtx, ctx.Token, ctx.RateLimiter, channelId)
 }
 
 func (ctx *Context) RemoveThreadMember(channelId, userId uint64) error {
-	return rest.RemoveThreadMember(context.Background(), ctx.Token, ctx.RateLimiter, channelId, userId)
+	spanCtx, span := ctx.startSpan("RemoveThreadMember")
+	defer span.End()
+
+	return rest.RemoveThreadMember(spanCtx, ctx.Token, ctx.RateLimiter, channelId, userId)
 }
 
 func (ctx *Context) GetThreadMember(channelId, userId uint64) (channel.ThreadMember, error) {
-	return rest.GetThreadMember(context.Background(), ctx.Token, ctx.RateLimiter, channelId, userId)
+	spanCtx, span := ctx.startSpan("GetThreadMember")
+	defer span.End()
+
+	return rest.GetThreadMember(spanCtx, ctx.Token, ctx.RateLimiter, channelId, userId)
 }
 
 func (ctx *Context) ListThreadMembers(channelId uint64) ([]channel.ThreadMember, error) {
-	return rest.ListThreadMembers(context.Background(), ctx.Token, ctx.RateLimiter, channelId)
+	spanCtx, span := ctx.startSpan("ListThreadMembers")
+	defer span.End()
+
+	return rest.ListThreadMembers(spanCtx, ctx.Token, ctx.RateLimiter, channelId)
 }
 
 func (ctx *Context) ListActiveThreads(channelId uint64) (rest.ThreadsResponse, error) {
-	return rest.ListActiveThreads(context.Background(), ctx.Token, ctx.RateLimiter, channelId)
+	spanCtx, span := ctx.startSpan("ListActiveThreads")
+	defer span.End()
+
+	return rest.ListActiveThreads(spanCtx, ctx.Token, ctx.RateLimiter, channelId)
 }
 
 func (ctx *Context) ListPublicArchivedThreads(channelId uint64, data rest.ListThreadsData) (rest.ThreadsResponse, error) {
-	return rest.ListPublicArchivedThreads(context.Background(), ctx.Token, ctx.RateLimiter, channelId, data)
+	spanCtx, span := ctx.startSpan("ListPublicArchivedThreads")
+	defer span.End()
+
+	return rest.ListPublicArchivedThreads(spanCtx, ctx.Token, ctx.RateLimiter, channelId, data)
 }
 
 func (ctx *Context) ListPrivateArchivedThreads(channelId uint64, data rest.ListThreadsData) (rest.ThreadsResponse, error) {
-	return rest.ListPrivateArchivedThreads(context.Background(), ctx.Token, ctx.RateLimiter, channelId, data)
+	spanCtx, span := ctx.startSpan("ListPrivateArchivedThreads")
+	defer span.End()
+
+	return rest.ListPrivateArchivedThreads(spanCtx, ctx.Token, ctx.RateLimiter, channelId, data)
 }
 
 func (ctx *Context) ListJoinedPrivateArchivedThreads(channelId uint64, data rest.ListThreadsData) (rest.ThreadsResponse, error) {
-	return rest.ListPrivateArchivedThreads(context.Background(), ctx.Token, ctx.RateLimiter, channelId, data)
+	spanCtx, span := ctx.startSpan("ListJoinedPrivateArchivedThreads")
+	defer span.End()
+
+	return rest.ListPrivateArchivedThreads(spanCtx, ctx.Token, ctx.RateLimiter, channelId, data)
 }
 
 func (ctx *Context) StartThreadWithMessage(channelId, messageId uint64, data rest.StartThreadWithMessageData) (channel.Channel, error) {
-	return rest.StartThreadWithMessage(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId, data)
+	spanCtx, span := ctx.startSpan("StartThreadWithMessage")
+	defer span.End()
+
+	return rest.StartThreadWithMessage(spanCtx, ctx.Token, ctx.RateLimiter, channelId, messageId, data)
 }
 
 func (ctx *Context) StartThreadWithoutMessage(channelId, messageId uint64, data rest.StartThreadWithoutMessageData) (channel.Channel, error) {
-	return rest.StartThreadWithoutMessage(context.Background(), ctx.Token, ctx.RateLimiter, channelId, data)
+	spanCtx, span := ctx.startSpan("StartThreadWithoutMessage")
+	defer span.End()
+
+	return rest.StartThreadWithoutMessage(spanCtx, ctx.Token, ctx.RateLimiter, channelId, data)
 }
 
 func (ctx *Context) CreatePublicThread(channelId uint64, name string, autoArchiveDuration uint16) (channel.Channel, error) {
+	spanCtx, span := ctx.startSpan("CreatePublicThread")
+	defer span.End()
+
 	data := rest.StartThreadWithoutMessageData{
 		Name:                name,
 		AutoArchiveDuration: autoArchiveDuration,
 		Type:                channel.ChannelTypeGuildPublicThread,
 	}
 
-	return rest.StartThreadWithoutMessage(context.Background(), ctx.Token, ctx.RateLimiter, channelId, data)
+	return rest.StartThreadWithoutMessage(spanCtx, ctx.Token, ctx.RateLimiter, channelId, data)
 }
 
 func (ctx *Context) CreatePrivateThread(channelId uint64, name string, autoArchiveDuration uint16, invitable bool) (channel.Channel, error) {
+	spanCtx, span := ctx.startSpan("CreatePrivateThread")
+	defer span.End()
+
 	data := rest.StartThreadWithoutMessageData{
 		Name:                name,
 		AutoArchiveDuration: autoArchiveDuration,
@@ -224,15 +334,18 @@ func (ctx *Context) CreatePrivateThread(channelId uint64, name string, autoArchi
 		Invitable:           invitable,
 	}
 
-	return rest.StartThreadWithoutMessage(context.Background(), ctx.Token, ctx.RateLimiter, channelId, data)
+	return rest.StartThreadWithoutMessage(spanCtx, ctx.Token, ctx.RateLimiter, channelId, data)
 }
 
 func (ctx *Context) ListGuildEmojis(guildId uint64) ([]emoji.Emoji, error) {
+	spanCtx, span := ctx.startSpan("ListGuildEmojis")
+	defer span.End()
+
 	shouldCacheEmoji := ctx.Cache.Options().Emojis
 	shouldCacheGuild := ctx.Cache.Options().Guilds
 
 	if shouldCacheEmoji && shouldCacheGuild {
-		guild, err := ctx.Cache.GetGuild(context.Background(), guildId)
+		guild, err := ctx.Cache.GetGuild(spanCtx, guildId)
 		if err == nil {
 			return guild.Emojis, nil
 		} else if !errors.Is(err, cache.ErrNotFound) {
@@ -240,19 +353,22 @@ func (ctx *Context) ListGuildEmojis(guildId uint64) ([]emoji.Emoji, error) {
 		} // else, continue
 	}
 
-	emojis, err := rest.ListGuildEmojis(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	emojis, err := rest.ListGuildEmojis(spanCtx, ctx.Token, ctx.RateLimiter, guildId)
 
 	if shouldCacheEmoji && err == nil {
-		go ctx.Cache.StoreEmojis(context.Background(), emojis, guildId)
+		go ctx.Cache.StoreEmojis(spanCtx, emojis, guildId)
 	}
 
 	return emojis, err
 }
 
 func (ctx *Context) GetGuildEmoji(guildId uint64, emojiId uint64) (emoji.Emoji, error) {
+	spanCtx, span := ctx.startSpan("GetGuildEmoji")
+	defer span.End()
+
 	shouldCache := ctx.Cache.Options().Emojis
 	if shouldCache {
-		e, err := ctx.Cache.GetEmoji(context.Background(), emojiId)
+		e, err := ctx.Cache.GetEmoji(spanCtx, emojiId)
 		if err == nil {
 			return e, nil
 		} else if !errors.Is(err, cache.ErrNotFound) {
@@ -260,33 +376,45 @@ func (ctx *Context) GetGuildEmoji(guildId uint64, emojiId uint64) (emoji.Emoji,
 		} // else, continue
 	}
 
-	emoji, err := rest.GetGuildEmoji(context.Background(), ctx.Token, ctx.RateLimiter, guildId, emojiId)
+	emoji, err := rest.GetGuildEmoji(spanCtx, ctx.Token, ctx.RateLimiter, guildId, emojiId)
 
 	if shouldCache && err == nil {
-		go ctx.Cache.StoreEmoji(context.Background(), emoji, guildId)
+		go ctx.Cache.StoreEmoji(spanCtx, emoji, guildId)
 	}
 
 	return emoji, err
 }
 
 func (ctx *Context) CreateGuildEmoji(guildId uint64, data rest.CreateEmojiData) (emoji.Emoji, error) {
-	return rest.CreateGuildEmoji(context.Background(), ctx.Token, ctx.RateLimiter, guildId, data)
+	spanCtx, span := ctx.startSpan("CreateGuildEmoji")
+	defer span.End()
+
+	return rest.CreateGuildEmoji(spanCtx, ctx.Token, ctx.RateLimiter, guildId, data)
 }
 
 // updating Image is not permitted
 func (ctx *Context) ModifyGuildEmoji(guildId, emojiId uint64, data rest.CreateEmojiData) (emoji.Emoji, error) {
-	return rest.ModifyGuildEmoji(context.Background(), ctx.Token, ctx.RateLimiter, guildId, emojiId, data)
+	spanCtx, span := ctx.startSpan("ModifyGuildEmoji")
+	defer span.End()
+
+	return rest.ModifyGuildEmoji(spanCtx, ctx.Token, ctx.RateLimiter, guildId, emojiId, data)
 }
 
 func (ctx *Context) CreateGuild(data rest.CreateGuildData) (guild.Guild, error) {
-	return rest.CreateGuild(context.Background(), ctx.Token, data)
+	spanCtx, span := ctx.startSpan("CreateGuild")
+	defer span.End()
+
+	return rest.CreateGuild(spanCtx, ctx.Token, data)
 }
 
 func (ctx *Context) GetGuild(guildId uint64) (guild.Guild, error) {
+	spanCtx, span := ctx.startSpan("GetGuild")
+	defer span.End()
+
 	shouldCache := ctx.Cache.Options().Guilds
 
 	if shouldCache {
-		cachedGuild, err := ctx.Cache.GetGuild(context.Background(), guildId)
+		cachedGuild, err := ctx.Cache.GetGuild(spanCtx, guildId)
 		if err == nil {
 			return cachedGuild, nil
 		} else if !errors.Is(err, cache.ErrNotFound) {
@@ -294,31 +422,43 @@ func (ctx *Context) GetGuild(guildId uint64) (guild.Guild, error) {
 		} // else, continue
 	}
 
-	guild, err := rest.GetGuild(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	guild, err := rest.GetGuild(spanCtx, ctx.Token, ctx.RateLimiter, guildId)
 	if err == nil {
-		go ctx.Cache.StoreGuild(context.Background(), guild)
+		go ctx.Cache.StoreGuild(spanCtx, guild)
 	}
 
 	return guild, err
 }
 
 func (ctx *Context) GetGuildPreview(guildId uint64) (guild.GuildPreview, error) {
-	return rest.GetGuildPreview(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	spanCtx, span := ctx.startSpan("GetGuildPreview")
+	defer span.End()
+
+	return rest.GetGuildPreview(spanCtx, ctx.Token, ctx.RateLimiter, guildId)
 }
 
 func (ctx *Context) ModifyGuild(guildId uint64, data rest.ModifyGuildData) (guild.Guild, error) {
-	return rest.ModifyGuild(context.Background(), ctx.Token, ctx.RateLimiter, guildId, data)
+	spanCtx, span := ctx.startSpan("ModifyGuild")
+	defer span.End()
+
+	return rest.ModifyGuild(spanCtx, ctx.Token, ctx.RateLimiter, guildId, data)
 }
 
 func (ctx *Context) DeleteGuild(guildId uint64) error {
-	return rest.DeleteGuild(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	spanCtx, span := ctx.startSpan("DeleteGuild")
+	defer span.End()
+
+	return rest.DeleteGuild(spanCtx, ctx.Token, ctx.RateLimiter, guildId)
 }
 
 func (ctx *Context) GetGuildChannels(guildId uint64) ([]channel.Channel, error) {
+	spanCtx, span := ctx.startSpan("GetGuildChannels")
+	defer span.End()
+
 	shouldCache := ctx.Cache.Options().Guilds && ctx.Cache.Options().Channels
 
 	if shouldCache {
-		cached, err := ctx.Cache.GetGuildChannels(context.Background(), guildId)
+		cached, err := ctx.Cache.GetGuildChannels(spanCtx, guildId)
 		if err != nil && !errors.Is(err, cache.ErrNotFound) {
 			return nil, err
 		} else if err == nil && len(cached) > 0 { // either not cached (more likely), or guild has no channels
@@ -326,29 +466,38 @@ func (ctx *Context) GetGuildChannels(guildId uint64) ([]channel.Channel, error)
 		} // else continue
 	}
 
-	channels, err := rest.GetGuildChannels(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	channels, err := rest.GetGuildChannels(spanCtx, ctx.Token, ctx.RateLimiter, guildId)
 
 	if shouldCache && err == nil {
-		go ctx.Cache.ReplaceChannels(context.Background(), guildId, channels)
+		go ctx.Cache.ReplaceChannels(spanCtx, guildId, channels)
 	}
 
 	return channels, err
 }
 
 func (ctx *Context) CreateGuildChannel(guildId uint64, data rest.CreateChannelData) (channel.Channel, error) {
-	return rest.CreateGuildChannel(context.Background(), ctx.Token, ctx.RateLimiter, guildId, data)
+	spanCtx, span := ctx.startSpan("CreateGuildChannel")
+	defer span.End()
+
+	return rest.CreateGuildChannel(spanCtx, ctx.Token, ctx.RateLimiter, guildId, data)
 }
 
 func (ctx *Context) ModifyGuildChannelPositions(guildId uint64, positions []rest.Position) error {
-	return rest.ModifyGuildChannelPositions(context.Background(), ctx.Token, ctx.RateLimiter, guildId, positions)
+	spanCtx, span := ctx.startSpan("ModifyGuildChannelPositions")
+	defer span.End()
+
+	return rest.ModifyGuildChannelPositions(spanCtx, ctx.Token, ctx.RateLimiter, guildId, positions)
 }
 
 func (ctx *Context) GetGuildMember(guildId, userId uint64) (member.Member, error) {
+	spanCtx, span := ctx.startSpan("GetGuildMember")
+	defer span.End()
+
 	cacheGuilds := ctx.Cache.Options().Guilds
 	cacheUsers := ctx.Cache.Options().Users
 
 	if cacheGuilds && cacheUsers {
-		m, err := ctx.Cache.GetMember(context.Background(), guildId, userId)
+		m, err := ctx.Cache.GetMember(spanCtx, guildId, userId)
 		if err == nil {
 			return m, nil
 		} else if !errors.Is(err, cache.ErrNotFound) {
@@ -356,73 +505,109 @@ func (ctx *Context) GetGuildMember(guildId, userId uint64) (member.Member, error
 		} // else, continue
 	}
 
-	member, err := rest.GetGuildMember(context.Background(), ctx.Token, ctx.RateLimiter, guildId, userId)
+	member, err := rest.GetGuildMember(spanCtx, ctx.Token, ctx.RateLimiter, guildId, userId)
 
 	if cacheGuilds && err == nil {
-		go ctx.Cache.StoreMember(context.Background(), member, guildId)
+		go ctx.Cache.StoreMember(spanCtx, member, guildId)
 	}
 
 	return member, err
 }
 
 func (ctx *Context) SearchGuildMembers(guildId uint64, data rest.SearchGuildMembersData) ([]member.Member, error) {
-	members, err := rest.SearchGuildMembers(context.Background(), ctx.Token, ctx.RateLimiter, guildId, data)
+	spanCtx, span := ctx.startSpan("SearchGuildMembers")
+	defer span.End()
+
+	members, err := rest.SearchGuildMembers(spanCtx, ctx.Token, ctx.RateLimiter, guildId, data)
 	if err == nil {
-		go ctx.Cache.StoreMembers(context.Background(), members, guildId)
+		go ctx.Cache.StoreMembers(spanCtx, members, guildId)
 	}
 
 	return members, err
 }
 
 func (ctx *Context) ListGuildMembers(guildId uint64, data rest.ListGuildMembersData) ([]member.Member, error) {
-	members, err := rest.ListGuildMembers(context.Background(), ctx.Token, ctx.RateLimiter, guildId, data)
+	spanCtx, span := ctx.startSpan("ListGuildMembers")
+	defer span.End()
+
+	members, err := rest.ListGuildMembers(spanCtx, ctx.Token, ctx.RateLimiter, guildId, data)
 	if err == nil {
-		go ctx.Cache.StoreMembers(context.Background(), members, guildId)
+		go ctx.Cache.StoreMembers(spanCtx, members, guildId)
 	}
 
 	return members, err
 }
 
 func (ctx *Context) ModifyGuildMember(guildId, userId uint64, data rest.ModifyGuildMemberData) error {
-	return rest.ModifyGuildMember(context.Background(), ctx.Token, ctx.RateLimiter, guildId, userId, data)
+	spanCtx, span := ctx.startSpan("ModifyGuildMember")
+	defer span.End()
+
+	return rest.ModifyGuildMember(spanCtx, ctx.Token, ctx.RateLimiter, guildId, userId, data)
 }
 
 func (ctx *Context) ModifyCurrentUserNick(guildId uint64, nick string) error {
-	return rest.ModifyCurrentUserNick(context.Background(), ctx.Token, ctx.RateLimiter, guildId, nick)
+	spanCtx, span := ctx.startSpan("ModifyCurrentUserNick")
+	defer span.End()
+
+	return rest.ModifyCurrentUserNick(spanCtx, ctx.Token, ctx.RateLimiter, guildId, nick)
 }
 
 func (ctx *Context) AddGuildMemberRole(guildId, userId, roleId uint64) error {
-	return rest.AddGuildMemberRole(context.Background(), ctx.Token, ctx.RateLimiter, guildId, userId, roleId)
+	spanCtx, span := ctx.startSpan("AddGuildMemberRole")
+	defer span.End()
+
+	return rest.AddGuildMemberRole(spanCtx, ctx.Token, ctx.RateLimiter, guildId, userId, roleId)
 }
 
 func (ctx *Context) RemoveGuildMemberRole(guildId, userId, roleId uint64) error {
-	return rest.RemoveGuildMemberRole(context.Background(), ctx.Token, ctx.RateLimiter, guildId, userId, roleId)
+	spanCtx, span := ctx.startSpan("RemoveGuildMemberRole")
+	defer span.End()
+
+	return rest.RemoveGuildMemberRole(spanCtx, ctx.Token, ctx.RateLimiter, guildId, userId, roleId)
 }
 
 func (ctx *Context) RemoveGuildMember(guildId, userId uint64) error {
-	return rest.RemoveGuildMember(context.Background(), ctx.Token, ctx.RateLimiter, guildId, userId)
+	spanCtx, span := ctx.startSpan("RemoveGuildMember")
+	defer span.End()
+
+	return rest.RemoveGuildMember(spanCtx, ctx.Token, ctx.RateLimiter, guildId, userId)
 }
 
 func (ctx *Context) GetGuildBans(guildId uint64, data rest.GetGuildBansData) ([]guild.Ban, error) {
-	return rest.GetGuildBans(context.Background(), ctx.Token, ctx.RateLimiter, guildId, data)
+	spanCtx, span := ctx.startSpan("GetGuildBans")
+	defer span.End()
+
+	return rest.GetGuildBans(spanCtx, ctx.Token, ctx.RateLimiter, guildId, data)
 }
 
 func (ctx *Context) GetGuildBan(guildId, userId uint64) (guild.Ban, error) {
-	return rest.GetGuildBan(context.Background(), ctx.Token, ctx.RateLimiter, guildId, userId)
+	spanCtx, span := ctx.startSpan("GetGuildBan")
+	defer span.End()
+
+	return rest.GetGuildBan(spanCtx, ctx.Token, ctx.RateLimiter, guildId, userId)
 }
 
 func (ctx *Context) CreateGuildBan(guildId, userId uint64, data rest.CreateGuildBanData) error {
-	return rest.CreateGuildBan(context.Background(), ctx.Token, ctx.RateLimiter, guildId, userId, data)
+	spanCtx, span := ctx.startSpan("CreateGuildBan")
+	defer span.End()
+
+	return rest.CreateGuildBan(spanCtx, ctx.Token, ctx.RateLimiter, guildId, userId, data)
 }
 
 func (ctx *Context) RemoveGuildBan(guildId, userId uint64) error {
-	return rest.RemoveGuildBan(context.Background(), ctx.Token, ctx.RateLimiter, guildId, userId)
+	spanCtx, span := ctx.startSpan("RemoveGuildBan")
+	defer span.End()
+
+	return rest.RemoveGuildBan(spanCtx, ctx.Token, ctx.RateLimiter, guildId, userId)
 }
 
 func (ctx *Context) GetGuildRoles(guildId uint64) ([]guild.Role, error) {
+	spanCtx, span := ctx.startSpan("GetGuildRoles")
+	defer span.End()
+
 	shouldCache := ctx.Cache.Options().Guilds && ctx.Cache.Options().Roles
 	if shouldCache {
-		cached, err := ctx.Cache.GetGuildRoles(context.Background(), guildId)
+		cached, err := ctx.Cache.GetGuildRoles(spanCtx, guildId)
 		if err != nil && !errors.Is(err, cache.ErrNotFound) {
 			return nil, err
 		} else if err == nil && len(cached) > 0 { // either not cached (more likely), or guild has no roles
@@ -430,111 +615,171 @@ func (ctx *Context) GetGuildRoles(guildId uint64) ([]guild.Role, error) {
 		} // else continue
 	}
 
-	roles, err := rest.GetGuildRoles(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	roles, err := rest.GetGuildRoles(spanCtx, ctx.Token, ctx.RateLimiter, guildId)
 
 	if shouldCache && err == nil {
-		go ctx.Cache.StoreRoles(context.Background(), roles, guildId)
+		go ctx.Cache.StoreRoles(spanCtx, roles, guildId)
 	}
 
 	return roles, err
 }
 
 func (ctx *Context) CreateGuildRole(guildId uint64, data rest.GuildRoleData) (guild.Role, error) {
-	return rest.CreateGuildRole(context.Background(), ctx.Token, ctx.RateLimiter, guildId, data)
+	spanCtx, span := ctx.startSpan("CreateGuildRole")
+	defer span.End()
+
+	return rest.CreateGuildRole(spanCtx, ctx.Token, ctx.RateLimiter, guildId, data)
 }
 
 func (ctx *Context) ModifyGuildRolePositions(guildId uint64, positions []rest.Position) ([]guild.Role, error) {
-	return rest.ModifyGuildRolePositions(context.Background(), ctx.Token, ctx.RateLimiter, guildId, positions)
+	spanCtx, span := ctx.startSpan("ModifyGuildRolePositions")
+	defer span.End()
+
+	return rest.ModifyGuildRolePositions(spanCtx, ctx.Token, ctx.RateLimiter, guildId, positions)
 }
 
 func (ctx *Context) ModifyGuildRole(guildId, roleId uint64, data rest.GuildRoleData) (guild.Role, error) {
-	return rest.ModifyGuildRole(context.Background(), ctx.Token, ctx.RateLimiter, guildId, roleId, data)
+	spanCtx, span := ctx.startSpan("ModifyGuildRole")
+	defer span.End()
+
+	return rest.ModifyGuildRole(spanCtx, ctx.Token, ctx.RateLimiter, guildId, roleId, data)
 }
 
 func (ctx *Context) DeleteGuildRole(guildId, roleId uint64) error {
-	return rest.DeleteGuildRole(context.Background(), ctx.Token, ctx.RateLimiter, guildId, roleId)
+	spanCtx, span := ctx.startSpan("DeleteGuildRole")
+	defer span.End()
+
+	return rest.DeleteGuildRole(spanCtx, ctx.Token, ctx.RateLimiter, guildId, roleId)
 }
 
 func (ctx *Context) GetGuildPruneCount(guildId uint64, days int) (int, error) {
-	return rest.GetGuildPruneCount(context.Background(), ctx.Token, ctx.RateLimiter, guildId, days)
+	spanCtx, span := ctx.startSpan("GetGuildPruneCount")
+	defer span.End()
+
+	return rest.GetGuildPruneCount(spanCtx, ctx.Token, ctx.RateLimiter, guildId, days)
 }
 
 // computePruneCount = whether 'pruned' is returned, discouraged for large guilds
 func (ctx *Context) BeginGuildPrune(guildId uint64, days int, computePruneCount bool) error {
-	return rest.BeginGuildPrune(context.Background(), ctx.Token, ctx.RateLimiter, guildId, days, computePruneCount)
+	spanCtx, span := ctx.startSpan("BeginGuildPrune")
+	defer span.End()
+
+	return rest.BeginGuildPrune(spanCtx, ctx.Token, ctx.RateLimiter, guildId, days, computePruneCount)
 }
 
 func (ctx *Context) GetGuildVoiceRegions(guildId uint64) ([]guild.VoiceRegion, error) {
-	return rest.GetGuildVoiceRegions(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	spanCtx, span := ctx.startSpan("GetGuildVoiceRegions")
+	defer span.End()
+
+	return rest.GetGuildVoiceRegions(spanCtx, ctx.Token, ctx.RateLimiter, guildId)
 }
 
 func (ctx *Context) GetGuildInvites(guildId uint64) ([]invite.InviteMetadata, error) {
-	return rest.GetGuildInvites(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	spanCtx, span := ctx.startSpan("GetGuildInvites")
+	defer span.End()
+
+	return rest.GetGuildInvites(spanCtx, ctx.Token, ctx.RateLimiter, guildId)
 }
 
 func (ctx *Context) GetGuildIntegrations(guildId uint64) ([]integration.Integration, error) {
-	return rest.GetGuildIntegrations(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	spanCtx, span := ctx.startSpan("GetGuildIntegrations")
+	defer span.End()
+
+	return rest.GetGuildIntegrations(spanCtx, ctx.Token, ctx.RateLimiter, guildId)
 }
 
 func (ctx *Context) CreateGuildIntegration(guildId uint64, data rest.CreateIntegrationData) error {
-	return rest.CreateGuildIntegration(context.Background(), ctx.Token, ctx.RateLimiter, guildId, data)
+	spanCtx, span := ctx.startSpan("CreateGuildIntegration")
+	defer span.End()
+
+	return rest.CreateGuildIntegration(spanCtx, ctx.Token, ctx.RateLimiter, guildId, data)
 }
 
 func (ctx *Context) ModifyGuildIntegration(guildId, integrationId uint64, data rest.ModifyIntegrationData) error {
-	return rest.ModifyGuildIntegration(context.Background(), ctx.Token, ctx.RateLimiter, guildId, integrationId, data)
+	spanCtx, span := ctx.startSpan("ModifyGuildIntegration")
+	defer span.End()
+
+	return rest.ModifyGuildIntegration(spanCtx, ctx.Token, ctx.RateLimiter, guildId, integrationId, data)
 }
 
 func (ctx *Context) DeleteGuildIntegration(guildId, integrationId uint64) error {
-	return rest.DeleteGuildIntegration(context.Background(), ctx.Token, ctx.RateLimiter, guildId, integrationId)
+	spanCtx, span := ctx.startSpan("DeleteGuildIntegration")
+	defer span.End()
+
+	return rest.DeleteGuildIntegration(spanCtx, ctx.Token, ctx.RateLimiter, guildId, integrationId)
 }
 
 func (ctx *Context) SyncGuildIntegration(guildId, integrationId uint64) error {
-	return rest.SyncGuildIntegration(context.Background(), ctx.Token, ctx.RateLimiter, guildId, integrationId)
+	spanCtx, span := ctx.startSpan("SyncGuildIntegration")
+	defer span.End()
+
+	return rest.SyncGuildIntegration(spanCtx, ctx.Token, ctx.RateLimiter, guildId, integrationId)
 }
 
 func (ctx *Context) GetGuildEmbed(guildId uint64) (guild.GuildWidget, error) {
-	return rest.GetGuildWidget(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	spanCtx, span := ctx.startSpan("GetGuildEmbed")
+	defer span.End()
+
+	return rest.GetGuildWidget(spanCtx, ctx.Token, ctx.RateLimiter, guildId)
 }
 
 func (ctx *Context) ModifyGuildEmbed(guildId uint64, data guild.GuildEmbed) (guild.GuildEmbed, error) {
-	return rest.ModifyGuildEmbed(context.Background(), ctx.Token, ctx.RateLimiter, guildId, data)
+	spanCtx, span := ctx.startSpan("ModifyGuildEmbed")
+	defer span.End()
+
+	return rest.ModifyGuildEmbed(spanCtx, ctx.Token, ctx.RateLimiter, guildId, data)
 }
 
 // returns invite object with only "code" and "uses" fields
 func (ctx *Context) GetGuildVanityUrl(guildId uint64) (invite.Invite, error) {
-	return rest.GetGuildVanityURL(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	spanCtx, span := ctx.startSpan("GetGuildVanityUrl")
+	defer span.End()
+
+	return rest.GetGuildVanityURL(spanCtx, ctx.Token, ctx.RateLimiter, guildId)
 }
 
 func (ctx *Context) GetInvite(inviteCode string, withCounts bool) (invite.Invite, error) {
-	return rest.GetInvite(context.Background(), ctx.Token, ctx.RateLimiter, inviteCode, withCounts)
+	spanCtx, span := ctx.startSpan("GetInvite")
+	defer span.End()
+
+	return rest.GetInvite(spanCtx, ctx.Token, ctx.RateLimiter, inviteCode, withCounts)
 }
 
 func (ctx *Context) DeleteInvite(inviteCode string) (invite.Invite, error) {
-	return rest.DeleteInvite(context.Background(), ctx.Token, ctx.RateLimiter, inviteCode)
+	spanCtx, span := ctx.startSpan("DeleteInvite")
+	defer span.End()
+
+	return rest.DeleteInvite(spanCtx, ctx.Token, ctx.RateLimiter, inviteCode)
 }
 
 func (ctx *Context) GetCurrentUser() (user.User, error) {
-	cached, err := ctx.Cache.GetSelf(context.Background())
+	spanCtx, span := ctx.startSpan("GetCurrentUser")
+	defer span.End()
+
+	cached, err := ctx.Cache.GetSelf(spanCtx)
 	if err == nil {
 		return cached, nil
 	} else if !errors.Is(err, cache.ErrNotFound) {
 		return user.User{}, err
 	} // else, continue
 
-	self, err := rest.GetCurrentUser(context.Background(), ctx.Token, ctx.RateLimiter)
+	self, err := rest.GetCurrentUser(spanCtx, ctx.Token, ctx.RateLimiter)
 
 	if err == nil {
-		go ctx.Cache.StoreSelf(context.Background(), self)
+		go ctx.Cache.StoreSelf(spanCtx, self)
 	}
 
 	return self, err
 }
 
 func (ctx *Context) GetUser(userId uint64) (user.User, error) {
+	spanCtx, span := ctx.startSpan("GetUser")
+	defer span.End()
+
 	shouldCache := ctx.Cache.Options().Users
 
 	if shouldCache {
-		cached, err := ctx.Cache.GetUser(context.Background(), userId)
+		cached, err := ctx.Cache.GetUser(spanCtx, userId)
 		if err == nil {
 			return cached, nil
 		} else if !errors.Is(err, cache.ErrNotFound) {
@@ -542,117 +787,195 @@ func (ctx *Context) GetUser(userId uint64) (user.User, error) {
 		} // else, continue
 	}
 
-	user, err := rest.GetUser(context.Background(), ctx.Token, ctx.RateLimiter, userId)
+	user, err := rest.GetUser(spanCtx, ctx.Token, ctx.RateLimiter, userId)
 
 	if shouldCache && err == nil {
-		go ctx.Cache.StoreUser(context.Background(), user)
+		go ctx.Cache.StoreUser(spanCtx, user)
 	}
 
 	return user, err
 }
 
 func (ctx *Context) ModifyCurrentUser(data rest.ModifyUserData) (user.User, error) {
-	return rest.ModifyCurrentUser(context.Background(), ctx.Token, ctx.RateLimiter, data)
+	spanCtx, span := ctx.startSpan("ModifyCurrentUser")
+	defer span.End()
+
+	return rest.ModifyCurrentUser(spanCtx, ctx.Token, ctx.RateLimiter, data)
 }
 
 func (ctx *Context) GetCurrentUserGuilds(data rest.CurrentUserGuildsData) ([]guild.Guild, error) {
-	return rest.GetCurrentUserGuilds(context.Background(), ctx.Token, ctx.RateLimiter, data)
+	spanCtx, span := ctx.startSpan("GetCurrentUserGuilds")
+	defer span.End()
+
+	return rest.GetCurrentUserGuilds(spanCtx, ctx.Token, ctx.RateLimiter, data)
 }
 
 func (ctx *Context) LeaveGuild(guildId uint64) error {
-	return rest.LeaveGuild(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	spanCtx, span := ctx.startSpan("LeaveGuild")
+	defer span.End()
+
+	return rest.LeaveGuild(spanCtx, ctx.Token, ctx.RateLimiter, guildId)
 }
 
 func (ctx *Context) CreateDM(recipientId uint64) (channel.Channel, error) {
-	return rest.CreateDM(context.Background(), ctx.Token, ctx.RateLimiter, recipientId)
+	spanCtx, span := ctx.startSpan("CreateDM")
+	defer span.End()
+
+	return rest.CreateDM(spanCtx, ctx.Token, ctx.RateLimiter, recipientId)
 }
 
 func (ctx *Context) GetUserConnections() ([]integration.Connection, error) {
-	return rest.GetUserConnections(context.Background(), ctx.Token, ctx.RateLimiter)
+	spanCtx, span := ctx.startSpan("GetUserConnections")
+	defer span.End()
+
+	return rest.GetUserConnections(spanCtx, ctx.Token, ctx.RateLimiter)
 }
 
 // GetGuildVoiceRegions should be preferred, as it returns VIP servers if available to the guild
 func (ctx *Context) ListVoiceRegions() ([]guild.VoiceRegion, error) {
-	return rest.ListVoiceRegions(context.Background(), ctx.Token)
+	spanCtx, span := ctx.startSpan("ListVoiceRegions")
+	defer span.End()
+
+	return rest.ListVoiceRegions(spanCtx, ctx.Token)
 }
 
 func (ctx *Context) CreateWebhook(channelId uint64, data rest.WebhookData) (guild.Webhook, error) {
-	return rest.CreateWebhook(context.Background(), ctx.Token, ctx.RateLimiter, channelId, data)
+	spanCtx, span := ctx.startSpan("CreateWebhook")
+	defer span.End()
+
+	return rest.CreateWebhook(spanCtx, ctx.Token, ctx.RateLimiter, channelId, data)
 }
 
 func (ctx *Context) GetChannelWebhooks(channelId uint64) ([]guild.Webhook, error) {
-	return rest.GetChannelWebhooks(context.Background(), ctx.Token, ctx.RateLimiter, channelId)
+	spanCtx, span := ctx.startSpan("GetChannelWebhooks")
+	defer span.End()
+
+	return rest.GetChannelWebhooks(spanCtx, ctx.Token, ctx.RateLimiter, channelId)
 }
 
 func (ctx *Context) GetGuildWebhooks(guildId uint64) ([]guild.Webhook, error) {
-	return rest.GetGuildWebhooks(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	spanCtx, span := ctx.startSpan("GetGuildWebhooks")
+	defer span.End()
+
+	return rest.GetGuildWebhooks(spanCtx, ctx.Token, ctx.RateLimiter, guildId)
 }
 
 func (ctx *Context) GetWebhook(webhookId uint64) (guild.Webhook, error) {
-	return rest.GetWebhook(context.Background(), ctx.Token, ctx.RateLimiter, webhookId)
+	spanCtx, span := ctx.startSpan("GetWebhook")
+	defer span.End()
+
+	return rest.GetWebhook(spanCtx, ctx.Token, ctx.RateLimiter, webhookId)
 }
 
 func (ctx *Context) ModifyWebhook(webhookId uint64, data rest.ModifyWebhookData) (guild.Webhook, error) {
-	return rest.ModifyWebhook(context.Background(), ctx.Token, ctx.RateLimiter, webhookId, data)
+	spanCtx, span := ctx.startSpan("ModifyWebhook")
+	defer span.End()
+
+	return rest.ModifyWebhook(spanCtx, ctx.Token, ctx.RateLimiter, webhookId, data)
 }
 
 func (ctx *Context) DeleteWebhook(webhookId uint64) error {
-	return rest.DeleteWebhook(context.Background(), ctx.Token, ctx.RateLimiter, webhookId)
+	spanCtx, span := ctx.startSpan("DeleteWebhook")
+	defer span.End()
+
+	return rest.DeleteWebhook(spanCtx, ctx.Token, ctx.RateLimiter, webhookId)
 }
 
 // if wait=true, a message object will be returned
 func (ctx *Context) ExecuteWebhook(webhookId uint64, webhookToken string, wait bool, data rest.WebhookBody) (*message.Message, error) {
-	return rest.ExecuteWebhook(context.Background(), webhookToken, ctx.RateLimiter, webhookId, wait, data)
+	spanCtx, span := ctx.startSpan("ExecuteWebhook")
+	defer span.End()
+
+	return rest.ExecuteWebhook(spanCtx, webhookToken, ctx.RateLimiter, webhookId, wait, data)
 }
 
 func (ctx *Context) GetGuildAuditLog(guildId uint64, data rest.GetGuildAuditLogData) (auditlog.AuditLog, error) {
-	return rest.GetGuildAuditLog(context.Background(), ctx.Token, ctx.RateLimiter, guildId, data)
+	spanCtx, span := ctx.startSpan("GetGuildAuditLog")
+	defer span.End()
+
+	return rest.GetGuildAuditLog(spanCtx, ctx.Token, ctx.RateLimiter, guildId, data)
 }
 
 func (ctx *Context) GetGlobalCommands(applicationId uint64) ([]interaction.ApplicationCommand, error) {
-	return rest.GetGlobalCommands(context.Background(), ctx.Token, ctx.RateLimiter, applicationId)
+	spanCtx, span := ctx.startSpan("GetGlobalCommands")
+	defer span.End()
+
+	return rest.GetGlobalCommands(spanCtx, ctx.Token, ctx.RateLimiter, applicationId)
 }
 
 func (ctx *Context) CreateGlobalCommand(applicationId uint64, data rest.CreateCommandData) (interaction.ApplicationCommand, error) {
-	return rest.CreateGlobalCommand(context.Background(), ctx.Token, ctx.RateLimiter, applicationId, data)
+	spanCtx, span := ctx.startSpan("CreateGlobalCommand")
+	defer span.End()
+
+	return rest.CreateGlobalCommand(spanCtx, ctx.Token, ctx.RateLimiter, applicationId, data)
 }
 
 func (ctx *Context) ModifyGlobalCommand(applicationId, commandId uint64, data rest.CreateCommandData) (interaction.ApplicationCommand, error) {
-	return rest.ModifyGlobalCommand(context.Background(), ctx.Token, ctx.RateLimiter, applicationId, commandId, data)
+	spanCtx, span := ctx.startSpan("ModifyGlobalCommand")
+	defer span.End()
+
+	return rest.ModifyGlobalCommand(spanCtx, ctx.Token, ctx.RateLimiter, applicationId, commandId, data)
 }
 
 func (ctx *Context) DeleteGlobalCommand(applicationId, commandId uint64) error {
-	return rest.DeleteGlobalCommand(context.Background(), ctx.Token, ctx.RateLimiter, applicationId, commandId)
+	spanCtx, span := ctx.startSpan("DeleteGlobalCommand")
+	defer span.End()
+
+	return rest.DeleteGlobalCommand(spanCtx, ctx.Token, ctx.RateLimiter, applicationId, commandId)
 }
 
 func (ctx *Context) GetGuildCommands(applicationId, guildId uint64) ([]interaction.ApplicationCommand, error) {
-	return rest.GetGuildCommands(context.Background(), ctx.Token, ctx.RateLimiter, applicationId, guildId)
+	spanCtx, span := ctx.startSpan("GetGuildCommands")
+	defer span.End()
+
+	return rest.GetGuildCommands(spanCtx, ctx.Token, ctx.RateLimiter, applicationId, guildId)
 }
 
 func (ctx *Context) CreateGuildCommand(applicationId, guildId uint64, data rest.CreateCommandData) (interaction.ApplicationCommand, error) {
-	return rest.CreateGuildCommand(context.Background(), ctx.Token, ctx.RateLimiter, applicationId, guildId, data)
+	spanCtx, span := ctx.startSpan("CreateGuildCommand")
+	defer span.End()
+
+	return rest.CreateGuildCommand(spanCtx, ctx.Token, ctx.RateLimiter, applicationId, guildId, data)
 }
 
 func (ctx *Context) ModifyGuildCommand(applicationId, guildId, commandId uint64, data rest.CreateCommandData) (interaction.ApplicationCommand, error) {
-	return rest.ModifyGuildCommand(context.Background(), ctx.Token, ctx.RateLimiter, applicationId, guildId, commandId, data)
+	spanCtx, span := ctx.startSpan("ModifyGuildCommand")
+	defer span.End()
+
+	return rest.ModifyGuildCommand(spanCtx, ctx.Token, ctx.RateLimiter, applicationId, guildId, commandId, data)
 }
 
 func (ctx *Context) DeleteGuildCommand(applicationId, guildId, commandId uint64) error {
-	return rest.DeleteGuildCommand(context.Background(), ctx.Token, ctx.RateLimiter, applicationId, guildId, commandId)
+	spanCtx, span := ctx.startSpan("DeleteGuildCommand")
+	defer span.End()
+
+	return rest.DeleteGuildCommand(spanCtx, ctx.Token, ctx.RateLimiter, applicationId, guildId, commandId)
 }
 
 func (ctx *Context) GetCommandPermissions(applicationId, guildId, commandId uint64) (rest.CommandWithPermissionsData, error) {
-	return rest.GetCommandPermissions(context.Background(), ctx.Token, ctx.RateLimiter, applicationId, guildId, commandId)
+	spanCtx, span := ctx.startSpan("GetCommandPermissions")
+	defer span.End()
+
+	return rest.GetCommandPermissions(spanCtx, ctx.Token, ctx.RateLimiter, applicationId, guildId, commandId)
 }
 
 func (ctx *Context) GetBulkCommandPermissions(applicationId, guildId uint64) ([]rest.CommandWithPermissionsData, error) {
-	return rest.GetBulkCommandPermissions(context.Background(), ctx.Token, ctx.RateLimiter, applicationId, guildId)
+	spanCtx, span := ctx.startSpan("GetBulkCommandPermissions")
+	defer span.End()
+
+	return rest.GetBulkCommandPermissions(spanCtx, ctx.Token, ctx.RateLimiter, applicationId, guildId)
 }
 
 func (ctx *Context) EditCommandPermissions(applicationId, guildId, commandId uint64, data rest.CommandWithPermissionsData) (rest.CommandWithPermissionsData, error) {
-	return rest.EditCommandPermissions(context.Background(), ctx.Token, ctx.RateLimiter, applicationId, guildId, commandId, data)
+	spanCtx, span := ctx.startSpan("EditCommandPermissions")
+	defer span.End()
+
+	return rest.EditCommandPermissions(spanCtx, ctx.Token, ctx.RateLimiter, applicationId, guildId, commandId, data)
 }
 
 func (ctx *Context) EditBulkCommandPermissions(applicationId, guildId uint64, data []rest.CommandWithPermissionsData) ([]rest.CommandWithPermissionsData, error) {
-	return rest.EditBulkCommandPermissions(context.Background(), ctx.Token, ctx.RateLimiter, applicationId, guildId, data)
+	spanCtx, span := ctx.startSpan("EditBulkCommandPermissions")
+	defer span.End()
+
+	return rest.EditBulkCommandPermissions(spanCtx, ctx.Token, ctx.RateLimiter, applicationId, guildId, data)
 }
@@ -0,0 +1,64 @@
+package testutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrTranscriptNotFound is returned by MemoryArchive when no transcript has been stored for a ticket
+var ErrTranscriptNotFound = errors.New("transcript not found")
+
+// MemoryArchive is an archiverclient.Retriever that keeps transcripts in memory
+type MemoryArchive struct {
+	mu          sync.Mutex
+	transcripts map[string][]byte
+}
+
+func NewMemoryArchive() *MemoryArchive {
+	return &MemoryArchive{
+		transcripts: make(map[string][]byte),
+	}
+}
+
+func (a *MemoryArchive) GetTicket(_ context.Context, guildId uint64, ticketId int) ([]byte, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	data, ok := a.transcripts[transcriptKey(guildId, ticketId)]
+	if !ok {
+		return nil, ErrTranscriptNotFound
+	}
+
+	return data, nil
+}
+
+func (a *MemoryArchive) StoreTicket(_ context.Context, guildId uint64, ticketId int, data []byte) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.transcripts[transcriptKey(guildId, ticketId)] = data
+	return nil
+}
+
+func (a *MemoryArchive) DeleteTicket(_ context.Context, guildId uint64, ticketId int) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	delete(a.transcripts, transcriptKey(guildId, ticketId))
+	return nil
+}
+
+// Has returns whether a transcript has been stored for the ticket
+func (a *MemoryArchive) Has(guildId uint64, ticketId int) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	_, ok := a.transcripts[transcriptKey(guildId, ticketId)]
+	return ok
+}
+
+func transcriptKey(guildId uint64, ticketId int) string {
+	return fmt.Sprintf("%d/%d", guildId, ticketId)
+}
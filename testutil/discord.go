@@ -0,0 +1,1152 @@
+package testutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/config"
+	"github.com/rxdn/gdl/objects"
+	"github.com/rxdn/gdl/objects/channel"
+	"github.com/rxdn/gdl/objects/channel/message"
+	"github.com/rxdn/gdl/objects/guild"
+	"github.com/rxdn/gdl/objects/member"
+	"github.com/rxdn/gdl/objects/user"
+	"github.com/rxdn/gdl/rest"
+	"github.com/rxdn/gdl/rest/request"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+const discordEpoch = 1420070400000
+
+var registerHook sync.Once
+
+// Error codes returned by the fake, matching those returned by Discord
+const (
+	codeUnknownChannel = 10003
+	codeUnknownGuild   = 10004
+	codeUnknownMember  = 10007
+	codeUnknownMessage = 10008
+	codeUnknownUser    = 10013
+	codeUnknownWebhook = 10015
+)
+
+// FakeDiscord is a stateful, in-memory implementation of the parts of the Discord REST API used by the worker. Point
+// the REST proxy hook at Host() to send the worker's requests to it.
+//
+// Only the state needed to exercise the ticket lifecycle is modelled: guilds, members, users, channels and their
+// permission overwrites, messages, threads and their members, webhooks and DM channels. Requests to any other
+// endpoint receive a 404, and are recorded, so that tests can detect when the worker starts using a new endpoint.
+type FakeDiscord struct {
+	server *httptest.Server
+
+	mu            sync.Mutex
+	sequence      uint64
+	bot           user.User
+	guilds        map[uint64]guild.Guild
+	members       map[uint64]map[uint64]member.Member
+	users         map[uint64]user.User
+	channels      map[uint64]channel.Channel
+	messages      map[uint64][]message.Message
+	threadMembers map[uint64]map[uint64]channel.ThreadMember
+	webhooks      map[uint64]guild.Webhook
+	dmChannels    map[uint64]uint64
+	followUps     []FollowUp
+	requests      []Request
+}
+
+// Request is a request received by the fake
+type Request struct {
+	Method  string
+	Path    string
+	Matched bool
+}
+
+// FollowUp is a message sent to an interaction webhook, rather than a channel
+type FollowUp struct {
+	ApplicationId uint64
+	Token         string
+	Data          rest.WebhookBody
+}
+
+// NewFakeDiscord starts a fake Discord API server, which is stopped when the test finishes. Requests are authenticated
+// as the bot user passed, which is also added to the fake's users.
+func NewFakeDiscord(t testing.TB, bot user.User) *FakeDiscord {
+	t.Helper()
+
+	bot.Bot = true
+
+	f := &FakeDiscord{
+		bot:           bot,
+		guilds:        make(map[uint64]guild.Guild),
+		members:       make(map[uint64]map[uint64]member.Member),
+		users:         map[uint64]user.User{bot.Id: bot},
+		channels:      make(map[uint64]channel.Channel),
+		messages:      make(map[uint64][]message.Message),
+		threadMembers: make(map[uint64]map[uint64]channel.ThreadMember),
+		webhooks:      make(map[uint64]guild.Webhook),
+		dmChannels:    make(map[uint64]uint64),
+	}
+
+	f.server = httptest.NewServer(f.routes())
+	t.Cleanup(f.server.Close)
+
+	return f
+}
+
+// Install points the worker's REST requests at the fake until the test finishes, using the same hook that sends
+// requests to the HTTP proxy in production
+func (f *FakeDiscord) Install(t testing.TB) {
+	t.Helper()
+
+	// The hook reads the proxy URL from the config on each request, so only needs registering once
+	registerHook.Do(func() {
+		request.RegisterPreRequestHook(utils.ProxyHook)
+	})
+
+	prev := config.Conf.Discord.ProxyUrl
+	t.Cleanup(func() {
+		config.Conf.Discord.ProxyUrl = prev
+	})
+
+	config.Conf.Discord.ProxyUrl = f.Host()
+}
+
+// Host returns the address of the fake, in the host:port form used by the REST proxy config
+func (f *FakeDiscord) Host() string {
+	return f.server.Listener.Addr().String()
+}
+
+// Bot returns the user that the worker is authenticated as
+func (f *FakeDiscord) Bot() user.User {
+	return f.bot
+}
+
+// NewId generates a unique snowflake, with the timestamp set to the current time
+func (f *FakeDiscord) NewId() uint64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.newId()
+}
+
+func (f *FakeDiscord) newId() uint64 {
+	f.sequence++
+	return uint64(time.Now().UnixMilli()-discordEpoch)<<22 | f.sequence&0x3FFFFF
+}
+
+// AddGuild adds a guild. Roles and channels in the guild object are stored as though they were created separately.
+func (f *FakeDiscord) AddGuild(g guild.Guild) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, ch := range g.Channels {
+		ch.GuildId = g.Id
+		f.channels[ch.Id] = ch
+	}
+
+	g.Channels = nil
+	f.guilds[g.Id] = g
+
+	if _, ok := f.members[g.Id]; !ok {
+		f.members[g.Id] = make(map[uint64]member.Member)
+	}
+
+	f.members[g.Id][f.bot.Id] = member.Member{User: f.bot, JoinedAt: time.Now()}
+}
+
+// AddMember adds a member to a guild, and their user object to the fake's users
+func (f *FakeDiscord) AddMember(guildId uint64, m member.Member) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, ok := f.members[guildId]; !ok {
+		f.members[guildId] = make(map[uint64]member.Member)
+	}
+
+	f.members[guildId][m.User.Id] = m
+	f.users[m.User.Id] = m.User
+}
+
+// RemoveMember removes a member from a guild, as though they had left
+func (f *FakeDiscord) RemoveMember(guildId, userId uint64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.members[guildId], userId)
+}
+
+// AddChannel adds a channel, assigning it an ID if it does not have one
+func (f *FakeDiscord) AddChannel(ch channel.Channel) channel.Channel {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if ch.Id == 0 {
+		ch.Id = f.newId()
+	}
+
+	f.channels[ch.Id] = ch
+	return ch
+}
+
+// Channel returns the current state of a channel or thread
+func (f *FakeDiscord) Channel(channelId uint64) (channel.Channel, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ch, ok := f.channels[channelId]
+	return ch, ok
+}
+
+// GuildChannels returns the channels and threads in a guild, ordered by ID
+func (f *FakeDiscord) GuildChannels(guildId uint64) []channel.Channel {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.guildChannels(guildId)
+}
+
+func (f *FakeDiscord) guildChannels(guildId uint64) []channel.Channel {
+	channels := make([]channel.Channel, 0)
+	for _, ch := range f.channels {
+		if ch.GuildId == guildId {
+			channels = append(channels, ch)
+		}
+	}
+
+	sort.Slice(channels, func(i, j int) bool {
+		return channels[i].Id < channels[j].Id
+	})
+
+	return channels
+}
+
+// Messages returns the messages in a channel, oldest first
+func (f *FakeDiscord) Messages(channelId uint64) []message.Message {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return slices.Clone(f.messages[channelId])
+}
+
+// AddMessage adds a message to a channel, as though it had been sent by the author
+func (f *FakeDiscord) AddMessage(channelId uint64, author user.User, content string) message.Message {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.addMessage(channelId, message.Message{Author: author, Content: content})
+}
+
+func (f *FakeDiscord) addMessage(channelId uint64, msg message.Message) message.Message {
+	msg.Id = f.newId()
+	msg.ChannelId = channelId
+	msg.GuildId = f.channels[channelId].GuildId
+	msg.Timestamp = time.Now()
+
+	f.messages[channelId] = append(f.messages[channelId], msg)
+
+	ch := f.channels[channelId]
+	ch.LastMessageId = objects.NewNullableSnowflake(msg.Id)
+	f.channels[channelId] = ch
+
+	return msg
+}
+
+// ThreadMembers returns the IDs of the users in a thread, in ascending order
+func (f *FakeDiscord) ThreadMembers(threadId uint64) []uint64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	ids := make([]uint64, 0, len(f.threadMembers[threadId]))
+	for id := range f.threadMembers[threadId] {
+		ids = append(ids, id)
+	}
+
+	slices.Sort(ids)
+	return ids
+}
+
+// Webhooks returns the webhooks created in a channel
+func (f *FakeDiscord) Webhooks(channelId uint64) []guild.Webhook {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var webhooks []guild.Webhook
+	for _, webhook := range f.webhooks {
+		if webhook.ChannelId == channelId {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+
+	return webhooks
+}
+
+// DMChannel returns the ID of the DM channel opened with the user, if one has been opened
+func (f *FakeDiscord) DMChannel(userId uint64) (uint64, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	id, ok := f.dmChannels[userId]
+	return id, ok
+}
+
+// FollowUps returns the messages sent to interaction webhooks
+func (f *FakeDiscord) FollowUps() []FollowUp {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return slices.Clone(f.followUps)
+}
+
+// Requests returns every request received, in order
+func (f *FakeDiscord) Requests() []Request {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return slices.Clone(f.requests)
+}
+
+// UnmatchedRequests returns the requests made to endpoints that the fake does not implement
+func (f *FakeDiscord) UnmatchedRequests() []Request {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var unmatched []Request
+	for _, req := range f.requests {
+		if !req.Matched {
+			unmatched = append(unmatched, req)
+		}
+	}
+
+	return unmatched
+}
+
+type handlerFunc func(w http.ResponseWriter, r *http.Request)
+
+func (f *FakeDiscord) routes() http.Handler {
+	mux := http.NewServeMux()
+
+	handle := func(pattern string, handler handlerFunc) {
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+
+			f.requests = append(f.requests, Request{Method: r.Method, Path: r.URL.Path, Matched: true})
+			handler(w, r)
+		})
+	}
+
+	// Channels
+	handle("GET /api/v10/channels/{channel}", f.getChannel)
+	handle("PATCH /api/v10/channels/{channel}", f.modifyChannel)
+	handle("DELETE /api/v10/channels/{channel}", f.deleteChannel)
+	handle("PUT /api/v10/channels/{channel}/permissions/{overwrite}", f.editPermissions)
+	handle("DELETE /api/v10/channels/{channel}/permissions/{overwrite}", f.deletePermissions)
+
+	// Messages
+	handle("GET /api/v10/channels/{channel}/messages", f.getMessages)
+	handle("POST /api/v10/channels/{channel}/messages", f.createMessage)
+	handle("GET /api/v10/channels/{channel}/messages/{message}", f.getMessage)
+	handle("PATCH /api/v10/channels/{channel}/messages/{message}", f.editMessage)
+	handle("DELETE /api/v10/channels/{channel}/messages/{message}", f.deleteMessage)
+
+	// Threads
+	handle("POST /api/v10/channels/{channel}/threads", f.startThread)
+	handle("GET /api/v10/channels/{channel}/thread-members", f.listThreadMembers)
+	handle("GET /api/v10/channels/{channel}/thread-members/{user}", f.getThreadMember)
+	handle("PUT /api/v10/channels/{channel}/thread-members/{user}", f.addThreadMember)
+	handle("DELETE /api/v10/channels/{channel}/thread-members/{user}", f.removeThreadMember)
+
+	// Webhooks
+	handle("POST /api/v10/channels/{channel}/webhooks", f.createWebhook)
+	handle("GET /api/v10/channels/{channel}/webhooks", f.getChannelWebhooks)
+	handle("POST /api/v10/webhooks/{webhook}/{token}", f.executeWebhook)
+	handle("PATCH /api/v10/webhooks/{webhook}/{token}/messages/{message}", f.editWebhookMessage)
+
+	// Guilds
+	handle("GET /api/v10/guilds/{guild}", f.getGuild)
+	handle("GET /api/v10/guilds/{guild}/channels", f.getGuildChannels)
+	handle("POST /api/v10/guilds/{guild}/channels", f.createGuildChannel)
+	handle("GET /api/v10/guilds/{guild}/roles", f.getGuildRoles)
+	handle("GET /api/v10/guilds/{guild}/members/{user}", f.getGuildMember)
+
+	// Users
+	handle("GET /api/v10/users/{user}", f.getUser)
+	handle("POST /api/v10/users/@me/channels", f.createDM)
+
+	// Interactions
+	handle("POST /api/v10/interactions/{interaction}/{token}/callback", f.interactionCallback)
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		f.requests = append(f.requests, Request{Method: r.Method, Path: r.URL.Path, Matched: false})
+		f.mu.Unlock()
+
+		writeError(w, http.StatusNotFound, 0, fmt.Sprintf("fake discord: no handler for %s %s", r.Method, r.URL.Path))
+	})
+
+	return mux
+}
+
+func (f *FakeDiscord) getChannel(w http.ResponseWriter, r *http.Request) {
+	ch, ok := f.channelFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	writeJson(w, http.StatusOK, ch)
+}
+
+func (f *FakeDiscord) modifyChannel(w http.ResponseWriter, r *http.Request) {
+	ch, ok := f.channelFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	var data rest.ModifyChannelData
+	if !readJson(w, r, &data) {
+		return
+	}
+
+	if data.Name != "" {
+		ch.Name = data.Name
+	}
+
+	if data.Topic != "" {
+		ch.Topic = data.Topic
+	}
+
+	if data.PermissionOverwrites != nil {
+		ch.PermissionOverwrites = data.PermissionOverwrites
+	}
+
+	if data.ParentId != 0 {
+		ch.ParentId = objects.NewNullableSnowflake(data.ParentId)
+	}
+
+	if data.ThreadMetadataModifyData != nil {
+		if ch.ThreadMetadata == nil {
+			writeError(w, http.StatusBadRequest, 50024, "Cannot execute action on this channel type")
+			return
+		}
+
+		if data.Archived != nil {
+			ch.ThreadMetadata.Archived = *data.Archived
+			ch.ThreadMetadata.ArchiveTimestamp = time.Now()
+		}
+
+		if data.Locked != nil {
+			ch.ThreadMetadata.Locked = *data.Locked
+		}
+
+		if data.AutoArchiveDuration != nil {
+			ch.ThreadMetadata.AutoArchiveDuration = *data.AutoArchiveDuration
+		}
+
+		if data.Invitable != nil {
+			ch.ThreadMetadata.Invitable = data.Invitable
+		}
+	}
+
+	f.channels[ch.Id] = ch
+	writeJson(w, http.StatusOK, ch)
+}
+
+func (f *FakeDiscord) deleteChannel(w http.ResponseWriter, r *http.Request) {
+	ch, ok := f.channelFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	delete(f.channels, ch.Id)
+	delete(f.messages, ch.Id)
+	delete(f.threadMembers, ch.Id)
+
+	writeJson(w, http.StatusOK, ch)
+}
+
+func (f *FakeDiscord) editPermissions(w http.ResponseWriter, r *http.Request) {
+	ch, ok := f.channelFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathId(w, r, "overwrite")
+	if !ok {
+		return
+	}
+
+	var overwrite channel.PermissionOverwrite
+	if !readJson(w, r, &overwrite) {
+		return
+	}
+
+	overwrite.Id = id
+	ch.PermissionOverwrites = slices.DeleteFunc(ch.PermissionOverwrites, func(o channel.PermissionOverwrite) bool {
+		return o.Id == id
+	})
+	ch.PermissionOverwrites = append(ch.PermissionOverwrites, overwrite)
+
+	f.channels[ch.Id] = ch
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (f *FakeDiscord) deletePermissions(w http.ResponseWriter, r *http.Request) {
+	ch, ok := f.channelFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	id, ok := pathId(w, r, "overwrite")
+	if !ok {
+		return
+	}
+
+	ch.PermissionOverwrites = slices.DeleteFunc(ch.PermissionOverwrites, func(o channel.PermissionOverwrite) bool {
+		return o.Id == id
+	})
+
+	f.channels[ch.Id] = ch
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getMessages returns messages newest first, as Discord does
+func (f *FakeDiscord) getMessages(w http.ResponseWriter, r *http.Request) {
+	ch, ok := f.channelFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	before, _ := strconv.ParseUint(query.Get("before"), 10, 64)
+	after, _ := strconv.ParseUint(query.Get("after"), 10, 64)
+
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit < 1 || limit > 100 {
+		limit = 50
+	}
+
+	messages := make([]message.Message, 0, limit)
+	for i := len(f.messages[ch.Id]) - 1; i >= 0 && len(messages) < limit; i-- {
+		msg := f.messages[ch.Id][i]
+		if (before == 0 || msg.Id < before) && msg.Id > after {
+			messages = append(messages, msg)
+		}
+	}
+
+	writeJson(w, http.StatusOK, messages)
+}
+
+func (f *FakeDiscord) createMessage(w http.ResponseWriter, r *http.Request) {
+	ch, ok := f.channelFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	if ch.ThreadMetadata != nil && ch.ThreadMetadata.Locked {
+		writeError(w, http.StatusForbidden, 50083, "Thread is archived")
+		return
+	}
+
+	var data rest.CreateMessageData
+	if !readMessageData(w, r, &data) {
+		return
+	}
+
+	msg := f.addMessage(ch.Id, newMessage(f.bot, data))
+	writeJson(w, http.StatusOK, msg)
+}
+
+func (f *FakeDiscord) getMessage(w http.ResponseWriter, r *http.Request) {
+	msg, _, ok := f.messageFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	writeJson(w, http.StatusOK, msg)
+}
+
+func (f *FakeDiscord) editMessage(w http.ResponseWriter, r *http.Request) {
+	msg, index, ok := f.messageFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	var data rest.EditMessageData
+	if !readJson(w, r, &data) {
+		return
+	}
+
+	now := time.Now()
+	msg.Content = data.Content
+	msg.Embeds = derefEmbeds(data.Embeds)
+	msg.Components = data.Components
+	msg.EditedTimestamp = &now
+
+	f.messages[msg.ChannelId][index] = msg
+	writeJson(w, http.StatusOK, msg)
+}
+
+func (f *FakeDiscord) deleteMessage(w http.ResponseWriter, r *http.Request) {
+	msg, index, ok := f.messageFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	f.messages[msg.ChannelId] = slices.Delete(f.messages[msg.ChannelId], index, index+1)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (f *FakeDiscord) startThread(w http.ResponseWriter, r *http.Request) {
+	parent, ok := f.channelFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	var data rest.StartThreadWithoutMessageData
+	if !readJson(w, r, &data) {
+		return
+	}
+
+	now := time.Now()
+	thread := channel.Channel{
+		Id:       f.newId(),
+		Type:     data.Type,
+		GuildId:  parent.GuildId,
+		Name:     data.Name,
+		OwnerId:  f.bot.Id,
+		ParentId: objects.NewNullableSnowflake(parent.Id),
+		ThreadMetadata: &channel.ThreadMetadata{
+			AutoArchiveDuration: data.AutoArchiveDuration,
+			Invitable:           &data.Invitable,
+			CreateTimestamp:     &now,
+		},
+	}
+
+	f.channels[thread.Id] = thread
+
+	// The creator of a thread is added to it
+	f.threadMembers[thread.Id] = map[uint64]channel.ThreadMember{
+		f.bot.Id: {ThreadId: thread.Id, UserId: f.bot.Id, JoinTimestamp: now},
+	}
+
+	writeJson(w, http.StatusCreated, thread)
+}
+
+func (f *FakeDiscord) listThreadMembers(w http.ResponseWriter, r *http.Request) {
+	thread, ok := f.threadFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	members := make([]channel.ThreadMember, 0, len(f.threadMembers[thread.Id]))
+	for _, m := range f.threadMembers[thread.Id] {
+		members = append(members, m)
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].UserId < members[j].UserId
+	})
+
+	writeJson(w, http.StatusOK, members)
+}
+
+func (f *FakeDiscord) getThreadMember(w http.ResponseWriter, r *http.Request) {
+	thread, ok := f.threadFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	userId, ok := f.userIdFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	m, ok := f.threadMembers[thread.Id][userId]
+	if !ok {
+		writeError(w, http.StatusNotFound, codeUnknownMember, "Unknown Member")
+		return
+	}
+
+	writeJson(w, http.StatusOK, m)
+}
+
+func (f *FakeDiscord) addThreadMember(w http.ResponseWriter, r *http.Request) {
+	thread, ok := f.threadFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	userId, ok := f.userIdFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	if _, ok := f.members[thread.GuildId][userId]; !ok {
+		writeError(w, http.StatusNotFound, codeUnknownMember, "Unknown Member")
+		return
+	}
+
+	if f.threadMembers[thread.Id] == nil {
+		f.threadMembers[thread.Id] = make(map[uint64]channel.ThreadMember)
+	}
+
+	f.threadMembers[thread.Id][userId] = channel.ThreadMember{
+		ThreadId:      thread.Id,
+		UserId:        userId,
+		JoinTimestamp: time.Now(),
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (f *FakeDiscord) removeThreadMember(w http.ResponseWriter, r *http.Request) {
+	thread, ok := f.threadFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	userId, ok := f.userIdFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	delete(f.threadMembers[thread.Id], userId)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (f *FakeDiscord) createWebhook(w http.ResponseWriter, r *http.Request) {
+	ch, ok := f.channelFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	var data rest.WebhookData
+	if !readJson(w, r, &data) {
+		return
+	}
+
+	webhook := guild.Webhook{
+		Id:        f.newId(),
+		Type:      guild.WebhookTypeIncoming,
+		GuildId:   ch.GuildId,
+		ChannelId: ch.Id,
+		User:      f.bot,
+		Name:      data.Username,
+		Token:     strconv.FormatUint(f.newId(), 36),
+	}
+
+	f.webhooks[webhook.Id] = webhook
+	writeJson(w, http.StatusOK, webhook)
+}
+
+func (f *FakeDiscord) getChannelWebhooks(w http.ResponseWriter, r *http.Request) {
+	ch, ok := f.channelFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	webhooks := make([]guild.Webhook, 0)
+	for _, webhook := range f.webhooks {
+		if webhook.ChannelId == ch.Id {
+			webhooks = append(webhooks, webhook)
+		}
+	}
+
+	writeJson(w, http.StatusOK, webhooks)
+}
+
+// executeWebhook handles both channel webhooks and interaction follow-ups, which share a route. Interaction webhooks
+// use the application ID in place of a webhook ID.
+func (f *FakeDiscord) executeWebhook(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathId(w, r, "webhook")
+	if !ok {
+		return
+	}
+
+	token := r.PathValue("token")
+
+	var data rest.WebhookBody
+	if !readMessageData(w, r, &data) {
+		return
+	}
+
+	webhook, ok := f.webhooks[id]
+	if !ok {
+		if id != f.bot.Id {
+			writeError(w, http.StatusNotFound, codeUnknownWebhook, "Unknown Webhook")
+			return
+		}
+
+		f.followUps = append(f.followUps, FollowUp{ApplicationId: id, Token: token, Data: data})
+		writeJson(w, http.StatusOK, newWebhookMessage(f.bot, data))
+		return
+	}
+
+	if webhook.Token != token {
+		writeError(w, http.StatusUnauthorized, 50027, "Invalid Webhook Token")
+		return
+	}
+
+	if _, ok := f.channels[webhook.ChannelId]; !ok {
+		writeError(w, http.StatusNotFound, codeUnknownChannel, "Unknown Channel")
+		return
+	}
+
+	author := user.User{Id: webhook.Id, Username: webhook.Name, Bot: true}
+	if data.Username != "" {
+		author.Username = data.Username
+	}
+
+	msg := newWebhookMessage(author, data)
+	msg.WebhookId = webhook.Id
+
+	msg = f.addMessage(webhook.ChannelId, msg)
+	if r.URL.Query().Get("wait") == "true" {
+		writeJson(w, http.StatusOK, msg)
+	} else {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (f *FakeDiscord) editWebhookMessage(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathId(w, r, "webhook")
+	if !ok {
+		return
+	}
+
+	var data rest.WebhookBody
+	if !readMessageData(w, r, &data) {
+		return
+	}
+
+	if id == f.bot.Id {
+		f.followUps = append(f.followUps, FollowUp{ApplicationId: id, Token: r.PathValue("token"), Data: data})
+	}
+
+	writeJson(w, http.StatusOK, newWebhookMessage(f.bot, data))
+}
+
+func (f *FakeDiscord) getGuild(w http.ResponseWriter, r *http.Request) {
+	g, ok := f.guildFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	writeJson(w, http.StatusOK, g)
+}
+
+func (f *FakeDiscord) getGuildChannels(w http.ResponseWriter, r *http.Request) {
+	g, ok := f.guildFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	// Threads are not returned by this endpoint
+	channels := slices.DeleteFunc(f.guildChannels(g.Id), func(ch channel.Channel) bool {
+		return ch.ThreadMetadata != nil
+	})
+
+	writeJson(w, http.StatusOK, channels)
+}
+
+func (f *FakeDiscord) createGuildChannel(w http.ResponseWriter, r *http.Request) {
+	g, ok := f.guildFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	var data rest.CreateChannelData
+	if !readJson(w, r, &data) {
+		return
+	}
+
+	ch := channel.Channel{
+		Id:                   f.newId(),
+		Type:                 data.Type,
+		GuildId:              g.Id,
+		Position:             data.Position,
+		PermissionOverwrites: data.PermissionOverwrites,
+		Name:                 data.Name,
+		Topic:                data.Topic,
+		Nsfw:                 data.Nsfw,
+		RateLimitPerUser:     data.RateLimitPerUser,
+		ParentId:             objects.NewNullSnowflake(),
+	}
+
+	if data.ParentId != 0 {
+		parent, ok := f.channels[data.ParentId]
+		if !ok || parent.GuildId != g.Id || parent.Type != channel.ChannelTypeGuildCategory {
+			writeError(w, http.StatusBadRequest, 50035, "Invalid Form Body")
+			return
+		}
+
+		ch.ParentId = objects.NewNullableSnowflake(data.ParentId)
+	}
+
+	f.channels[ch.Id] = ch
+	writeJson(w, http.StatusCreated, ch)
+}
+
+func (f *FakeDiscord) getGuildRoles(w http.ResponseWriter, r *http.Request) {
+	g, ok := f.guildFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	roles := g.Roles
+	if roles == nil {
+		roles = []guild.Role{}
+	}
+
+	writeJson(w, http.StatusOK, roles)
+}
+
+func (f *FakeDiscord) getGuildMember(w http.ResponseWriter, r *http.Request) {
+	g, ok := f.guildFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	userId, ok := f.userIdFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	m, ok := f.members[g.Id][userId]
+	if !ok {
+		writeError(w, http.StatusNotFound, codeUnknownMember, "Unknown Member")
+		return
+	}
+
+	writeJson(w, http.StatusOK, m)
+}
+
+func (f *FakeDiscord) getUser(w http.ResponseWriter, r *http.Request) {
+	userId, ok := f.userIdFromPath(w, r)
+	if !ok {
+		return
+	}
+
+	u, ok := f.users[userId]
+	if !ok {
+		writeError(w, http.StatusNotFound, codeUnknownUser, "Unknown User")
+		return
+	}
+
+	writeJson(w, http.StatusOK, u)
+}
+
+func (f *FakeDiscord) createDM(w http.ResponseWriter, r *http.Request) {
+	var data struct {
+		RecipientId uint64 `json:"recipient_id,string"`
+	}
+
+	if !readJson(w, r, &data) {
+		return
+	}
+
+	recipient, ok := f.users[data.RecipientId]
+	if !ok {
+		writeError(w, http.StatusNotFound, codeUnknownUser, "Unknown User")
+		return
+	}
+
+	if id, ok := f.dmChannels[recipient.Id]; ok {
+		writeJson(w, http.StatusOK, f.channels[id])
+		return
+	}
+
+	ch := channel.Channel{
+		Id:         f.newId(),
+		Type:       channel.ChannelTypeDM,
+		Recipients: []user.User{recipient},
+	}
+
+	f.channels[ch.Id] = ch
+	f.dmChannels[recipient.Id] = ch.Id
+
+	writeJson(w, http.StatusOK, ch)
+}
+
+func (f *FakeDiscord) interactionCallback(w http.ResponseWriter, r *http.Request) {
+	_, _ = io.Copy(io.Discard, r.Body)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (f *FakeDiscord) channelFromPath(w http.ResponseWriter, r *http.Request) (channel.Channel, bool) {
+	id, ok := pathId(w, r, "channel")
+	if !ok {
+		return channel.Channel{}, false
+	}
+
+	ch, ok := f.channels[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, codeUnknownChannel, "Unknown Channel")
+		return channel.Channel{}, false
+	}
+
+	return ch, true
+}
+
+func (f *FakeDiscord) threadFromPath(w http.ResponseWriter, r *http.Request) (channel.Channel, bool) {
+	ch, ok := f.channelFromPath(w, r)
+	if !ok {
+		return channel.Channel{}, false
+	}
+
+	if ch.ThreadMetadata == nil {
+		writeError(w, http.StatusBadRequest, 50024, "Cannot execute action on this channel type")
+		return channel.Channel{}, false
+	}
+
+	return ch, true
+}
+
+func (f *FakeDiscord) messageFromPath(w http.ResponseWriter, r *http.Request) (message.Message, int, bool) {
+	ch, ok := f.channelFromPath(w, r)
+	if !ok {
+		return message.Message{}, 0, false
+	}
+
+	id, ok := pathId(w, r, "message")
+	if !ok {
+		return message.Message{}, 0, false
+	}
+
+	index := slices.IndexFunc(f.messages[ch.Id], func(msg message.Message) bool {
+		return msg.Id == id
+	})
+
+	if index == -1 {
+		writeError(w, http.StatusNotFound, codeUnknownMessage, "Unknown Message")
+		return message.Message{}, 0, false
+	}
+
+	return f.messages[ch.Id][index], index, true
+}
+
+func (f *FakeDiscord) guildFromPath(w http.ResponseWriter, r *http.Request) (guild.Guild, bool) {
+	id, ok := pathId(w, r, "guild")
+	if !ok {
+		return guild.Guild{}, false
+	}
+
+	g, ok := f.guilds[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, codeUnknownGuild, "Unknown Guild")
+		return guild.Guild{}, false
+	}
+
+	return g, true
+}
+
+// userIdFromPath resolves @me to the bot's ID
+func (f *FakeDiscord) userIdFromPath(w http.ResponseWriter, r *http.Request) (uint64, bool) {
+	if r.PathValue("user") == "@me" {
+		return f.bot.Id, true
+	}
+
+	return pathId(w, r, "user")
+}
+
+func pathId(w http.ResponseWriter, r *http.Request, name string) (uint64, bool) {
+	id, err := strconv.ParseUint(r.PathValue(name), 10, 64)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, 50035, fmt.Sprintf("Invalid Form Body: %s is not snowflake", name))
+		return 0, false
+	}
+
+	return id, true
+}
+
+func newMessage(author user.User, data rest.CreateMessageData) message.Message {
+	return message.Message{
+		Author:     author,
+		Content:    data.Content,
+		Embeds:     derefEmbeds(data.Embeds),
+		Components: data.Components,
+		Flags:      int(data.Flags),
+	}
+}
+
+func newWebhookMessage(author user.User, data rest.WebhookBody) message.Message {
+	return message.Message{
+		Author:     author,
+		Content:    data.Content,
+		Embeds:     derefEmbeds(data.Embeds),
+		Components: data.Components,
+		Flags:      int(data.Flags),
+	}
+}
+
+func derefEmbeds[T any](embeds []*T) []T {
+	values := make([]T, 0, len(embeds))
+	for _, e := range embeds {
+		if e != nil {
+			values = append(values, *e)
+		}
+	}
+
+	return values
+}
+
+func readJson(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil && err != io.EOF {
+		writeError(w, http.StatusBadRequest, 50109, fmt.Sprintf("The request body contains invalid JSON: %v", err))
+		return false
+	}
+
+	return true
+}
+
+// readMessageData reads the message from either a JSON body, or the payload_json field of a multipart body, which is
+// used when files are attached
+func readMessageData(w http.ResponseWriter, r *http.Request, data any) bool {
+	mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/form-data" {
+		return readJson(w, r, data)
+	}
+
+	reader := multipart.NewReader(r.Body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			writeError(w, http.StatusBadRequest, 50035, fmt.Sprintf("Invalid multipart body: %v", err))
+			return false
+		}
+
+		if part.FormName() == "payload_json" {
+			if err := json.NewDecoder(part).Decode(data); err != nil {
+				writeError(w, http.StatusBadRequest, 50109, fmt.Sprintf("The request body contains invalid JSON: %v", err))
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func writeJson(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status, code int, message string) {
+	writeJson(w, status, map[string]any{
+		"code":    code,
+		"message": message,
+	})
+}
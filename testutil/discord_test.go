@@ -0,0 +1,204 @@
+package testutil
+
+import (
+	"errors"
+	"github.com/TicketsBot/worker"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/rxdn/gdl/cache"
+	"github.com/rxdn/gdl/objects"
+	"github.com/rxdn/gdl/objects/channel"
+	"github.com/rxdn/gdl/objects/guild"
+	"github.com/rxdn/gdl/objects/member"
+	"github.com/rxdn/gdl/objects/user"
+	"github.com/rxdn/gdl/rest"
+	"github.com/rxdn/gdl/rest/request"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func newTestWorker(t *testing.T) (*FakeDiscord, *worker.Context, guild.Guild, channel.Channel) {
+	t.Helper()
+
+	bot := user.User{Id: 508391840525975553, Username: "Tickets"}
+	f := NewFakeDiscord(t, bot)
+	f.Install(t)
+
+	text := channel.Channel{
+		Id:       f.NewId(),
+		Type:     channel.ChannelTypeGuildText,
+		Name:     "support",
+		ParentId: objects.NewNullSnowflake(),
+	}
+
+	g := guild.Guild{
+		Id:       f.NewId(),
+		Name:     "Test Server",
+		Channels: []channel.Channel{text},
+	}
+
+	f.AddGuild(g)
+	text.GuildId = g.Id
+
+	// Disable caching, so that every read is served by the fake
+	noCache := cache.NewPgCache(nil, cache.CacheOptions{})
+
+	return f, &worker.Context{Token: "fake-token", BotId: bot.Id, Cache: &noCache}, g, text
+}
+
+func TestFakeDiscordChannels(t *testing.T) {
+	f, w, g, _ := newTestWorker(t)
+
+	opener := member.Member{User: user.User{Id: f.NewId(), Username: "opener"}, JoinedAt: time.Now()}
+	f.AddMember(g.Id, opener)
+
+	created, err := w.CreateGuildChannel(g.Id, rest.CreateChannelData{
+		Name: "ticket-1",
+		Type: channel.ChannelTypeGuildText,
+		PermissionOverwrites: []channel.PermissionOverwrite{
+			{Id: g.Id, Type: channel.PermissionTypeRole, Deny: 1024},
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create channel: %v", err)
+	}
+
+	if err := w.EditChannelPermissions(created.Id, channel.PermissionOverwrite{
+		Id:    opener.User.Id,
+		Type:  channel.PermissionTypeMember,
+		Allow: 1024,
+	}); err != nil {
+		t.Fatalf("failed to edit permissions: %v", err)
+	}
+
+	fetched, err := w.GetChannel(created.Id)
+	if err != nil {
+		t.Fatalf("failed to get channel: %v", err)
+	}
+
+	if fetched.Name != "ticket-1" || len(fetched.PermissionOverwrites) != 2 {
+		t.Fatalf("unexpected channel state: %+v", fetched)
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := w.CreateMessage(created.Id, "hello"); err != nil {
+			t.Fatalf("failed to send message: %v", err)
+		}
+	}
+
+	// Messages are returned newest first, and paginated with before
+	page, err := w.GetChannelMessages(created.Id, rest.GetChannelMessagesData{Limit: 2})
+	if err != nil {
+		t.Fatalf("failed to get messages: %v", err)
+	}
+
+	if len(page) != 2 || page[0].Id < page[1].Id {
+		t.Fatalf("expected the 2 newest messages, newest first, got %+v", page)
+	}
+
+	remaining, err := w.GetChannelMessages(created.Id, rest.GetChannelMessagesData{Before: page[1].Id, Limit: 2})
+	if err != nil {
+		t.Fatalf("failed to get messages: %v", err)
+	}
+
+	if len(remaining) != 1 {
+		t.Fatalf("expected 1 remaining message, got %d", len(remaining))
+	}
+
+	if _, err := w.DeleteChannel(created.Id); err != nil {
+		t.Fatalf("failed to delete channel: %v", err)
+	}
+
+	_, err = w.GetChannel(created.Id)
+
+	var restError request.RestError
+	if !errors.As(err, &restError) || restError.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected a 404 for a deleted channel, got %v", err)
+	}
+
+	if unmatched := f.UnmatchedRequests(); len(unmatched) > 0 {
+		t.Fatalf("requests were made to unimplemented endpoints: %+v", unmatched)
+	}
+}
+
+func TestFakeDiscordThreads(t *testing.T) {
+	f, w, g, text := newTestWorker(t)
+
+	opener := member.Member{User: user.User{Id: f.NewId(), Username: "opener"}, JoinedAt: time.Now()}
+	f.AddMember(g.Id, opener)
+
+	thread, err := w.CreatePrivateThread(text.Id, "ticket-1", 10080, false)
+	if err != nil {
+		t.Fatalf("failed to create thread: %v", err)
+	}
+
+	if err := w.AddThreadMember(thread.Id, opener.User.Id); err != nil {
+		t.Fatalf("failed to add thread member: %v", err)
+	}
+
+	if members := f.ThreadMembers(thread.Id); len(members) != 2 {
+		t.Fatalf("expected the bot and opener in the thread, got %v", members)
+	}
+
+	// Users who are not in the guild can't be added
+	if err := w.AddThreadMember(thread.Id, f.NewId()); err == nil {
+		t.Fatal("expected an error adding a non-member to a thread")
+	}
+
+	if _, err := w.ModifyChannel(thread.Id, rest.ModifyChannelData{
+		ThreadMetadataModifyData: &rest.ThreadMetadataModifyData{
+			Archived: utils.Ptr(true),
+			Locked:   utils.Ptr(true),
+		},
+	}); err != nil {
+		t.Fatalf("failed to archive thread: %v", err)
+	}
+
+	archived, _ := f.Channel(thread.Id)
+	if !archived.ThreadMetadata.Archived || !archived.ThreadMetadata.Locked {
+		t.Fatalf("expected thread to be archived and locked, got %+v", archived.ThreadMetadata)
+	}
+
+	if _, err := w.CreateMessage(thread.Id, "hello"); err == nil {
+		t.Fatal("expected an error sending a message to a locked thread")
+	}
+
+	// Threads are not listed with the guild's channels
+	channels, err := w.GetGuildChannels(g.Id)
+	if err != nil {
+		t.Fatalf("failed to get guild channels: %v", err)
+	}
+
+	if len(channels) != 1 || channels[0].Id != text.Id {
+		t.Fatalf("expected only the text channel, got %+v", channels)
+	}
+}
+
+func TestFakeDiscordWebhooks(t *testing.T) {
+	f, w, _, text := newTestWorker(t)
+
+	webhook, err := w.CreateWebhook(text.Id, rest.WebhookData{Username: "Tickets"})
+	if err != nil {
+		t.Fatalf("failed to create webhook: %v", err)
+	}
+
+	msg, err := w.ExecuteWebhook(webhook.Id, webhook.Token, true, rest.WebhookBody{
+		Content:  "relayed",
+		Username: "opener",
+	})
+	if err != nil {
+		t.Fatalf("failed to execute webhook: %v", err)
+	}
+
+	if msg == nil || msg.WebhookId != webhook.Id || msg.Author.Username != "opener" {
+		t.Fatalf("unexpected webhook message: %+v", msg)
+	}
+
+	if messages := f.Messages(text.Id); len(messages) != 1 || messages[0].Content != "relayed" {
+		t.Fatalf("expected the webhook message in the channel, got %+v", messages)
+	}
+
+	if _, err := w.ExecuteWebhook(webhook.Id, "wrong", true, rest.WebhookBody{Content: "x"}); err == nil {
+		t.Fatal("expected an error executing a webhook with the wrong token")
+	}
+}
@@ -0,0 +1,281 @@
+// Package testutil provides a fake Discord API and helpers for running the bot's logic end-to-end in tests.
+//
+// Tests that need a database or Redis use throwaway instances on the servers given by WORKER_TEST_POSTGRES_URL and
+// WORKER_TEST_REDIS_ADDR, and are skipped if they are not set.
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"github.com/TicketsBot/archiverclient"
+	"github.com/TicketsBot/common/premium"
+	"github.com/TicketsBot/database"
+	"github.com/TicketsBot/worker"
+	"github.com/TicketsBot/worker/bot/cache"
+	cmdcontext "github.com/TicketsBot/worker/bot/command/context"
+	"github.com/TicketsBot/worker/bot/dbclient"
+	"github.com/TicketsBot/worker/bot/redis"
+	"github.com/TicketsBot/worker/bot/utils"
+	"github.com/TicketsBot/worker/bot/workerdb"
+	"github.com/TicketsBot/worker/config"
+	"github.com/TicketsBot/worker/i18n"
+	gdlcache "github.com/rxdn/gdl/cache"
+	"github.com/rxdn/gdl/objects"
+	"github.com/rxdn/gdl/objects/channel"
+	"github.com/rxdn/gdl/objects/guild"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/rxdn/gdl/objects/member"
+	"github.com/rxdn/gdl/objects/user"
+	"github.com/rxdn/gdl/permission"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// archiveKey is the AES-256 key transcripts are encrypted with in tests
+const archiveKey = "0123456789abcdef0123456789abcdef"
+
+// Env is an end-to-end test environment: a fake Discord API, a throwaway database, cache and Redis, and an in-memory
+// transcript archive, all installed as the globals that the bot packages use. Tests using an Env must not run in
+// parallel with each other.
+type Env struct {
+	Discord *FakeDiscord
+	Archive *MemoryArchive
+	Worker  *worker.Context
+
+	// Guild is a server containing a text channel, a ticket category, and the members added with AddMember
+	Guild    guild.Guild
+	Channel  channel.Channel
+	Category channel.Channel
+	Owner    member.Member
+
+	Premium *StaticPremium
+}
+
+// NewEnv sets up an Env for the test, skipping the test if Postgres or Redis has not been configured. Globals are
+// restored when the test finishes.
+func NewEnv(t testing.TB) *Env {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+
+	// Create the Redis client first, so that the test skips before creating any databases
+	redisClient := NewRedis(t)
+	dbPool := NewPostgres(t)
+	cachePool := NewPostgres(t)
+
+	db := database.NewDatabase(dbPool)
+	db.CreateTables(ctx, dbPool)
+
+	workerDb := workerdb.NewDatabase(dbPool)
+	if _, err := workerDb.Migrate(ctx); err != nil {
+		t.Fatalf("failed to migrate worker tables: %v", err)
+	}
+
+	pgCache := gdlcache.NewPgCache(cachePool, gdlcache.CacheOptions{
+		Guilds:   true,
+		Users:    true,
+		Members:  true,
+		Channels: true,
+	})
+
+	if err := pgCache.CreateSchema(ctx); err != nil {
+		t.Fatalf("failed to create cache schema: %v", err)
+	}
+
+	bot := user.User{Id: 508391840525975553, Username: "Tickets"}
+	discord := NewFakeDiscord(t, bot)
+	archive := NewMemoryArchive()
+	premiumClient := &StaticPremium{Tier: premium.None}
+
+	// Save the globals, so that they can be restored
+	prevConf := config.Conf
+	prevDb, prevWorkerDb, prevCache, prevRedis := dbclient.Client, dbclient.WorkerDb, cache.Client, redis.Client
+	prevArchiver, prevPremium := utils.ArchiverClient, utils.PremiumClient
+	prevMessages := i18n.LocaleEnglish.Messages
+
+	t.Cleanup(func() {
+		config.Conf = prevConf
+		dbclient.Client, dbclient.WorkerDb, cache.Client = prevDb, prevWorkerDb, prevCache
+		utils.ArchiverClient, utils.PremiumClient = prevArchiver, prevPremium
+		i18n.LocaleEnglish.Messages = prevMessages
+
+		if prevRedis != nil {
+			redis.UseClient(prevRedis)
+		} else {
+			redis.Client = nil
+		}
+	})
+
+	discord.Install(t)
+	config.Conf.Discord.PublicBotId = bot.Id
+	dbclient.Client = db
+	dbclient.WorkerDb = workerDb
+	cache.Client = &pgCache
+	redis.UseClient(redisClient)
+	utils.ArchiverClient = archiverclient.NewArchiverClient(archive, []byte(archiveKey))
+	utils.PremiumClient = premiumClient
+
+	// Locale files are not available to tests. With an empty message map, each message is rendered as an error
+	// containing its ID, which Replies.Contains matches on.
+	if i18n.LocaleEnglish.Messages == nil {
+		i18n.LocaleEnglish.Messages = make(map[i18n.MessageId]string)
+	}
+
+	env := &Env{
+		Discord: discord,
+		Archive: archive,
+		Worker: &worker.Context{
+			Token: "fake-token",
+			BotId: bot.Id,
+			Cache: &pgCache,
+		},
+		Premium: premiumClient,
+	}
+
+	env.seedGuild()
+	return env
+}
+
+func (e *Env) seedGuild() {
+	guildId := e.Discord.NewId()
+
+	e.Owner = member.Member{
+		User:     user.User{Id: e.Discord.NewId(), Username: "owner"},
+		JoinedAt: time.Now(),
+	}
+
+	e.Category = channel.Channel{
+		Id:       e.Discord.NewId(),
+		Type:     channel.ChannelTypeGuildCategory,
+		GuildId:  guildId,
+		Name:     "Tickets",
+		ParentId: objects.NewNullSnowflake(),
+	}
+
+	e.Channel = channel.Channel{
+		Id:       e.Discord.NewId(),
+		Type:     channel.ChannelTypeGuildText,
+		GuildId:  guildId,
+		Name:     "support",
+		ParentId: objects.NewNullSnowflake(),
+	}
+
+	e.Guild = guild.Guild{
+		Id:      guildId,
+		Name:    "Test Server",
+		OwnerId: e.Owner.User.Id,
+		Roles: []guild.Role{
+			{
+				Id:          guildId, // @everyone
+				Name:        "@everyone",
+				Permissions: permission.BuildPermissions(permission.ViewChannel, permission.SendMessages, permission.SendMessagesInThreads),
+			},
+			{
+				Id:          e.Discord.NewId(),
+				Name:        e.Discord.Bot().Username,
+				Permissions: permission.BuildPermissions(permission.Administrator),
+				Managed:     true,
+				Tags:        guild.RoleTags{BotId: &e.Worker.BotId},
+			},
+		},
+		Channels: []channel.Channel{e.Category, e.Channel},
+	}
+
+	e.Discord.AddGuild(e.Guild)
+	e.Discord.AddMember(guildId, e.Owner)
+}
+
+// AddMember adds a member to the test guild with the given username
+func (e *Env) AddMember(username string) member.Member {
+	m := member.Member{
+		User:     user.User{Id: e.Discord.NewId(), Username: username},
+		JoinedAt: time.Now(),
+	}
+
+	e.Discord.AddMember(e.Guild.Id, m)
+	return m
+}
+
+// SlashCommand builds the context for a slash command run by the member in the channel. Replies are collected by the
+// returned Replies, rather than being sent to Discord.
+func (e *Env) SlashCommand(ctx context.Context, m member.Member, channelId uint64, name string) (*cmdcontext.SlashCommandContext, *Replies) {
+	replies := newReplies()
+
+	data := interaction.ApplicationCommandInteraction{
+		InteractionMetadata: interaction.InteractionMetadata{
+			Interaction: interaction.Interaction{
+				Version: 1,
+				Type:    interaction.InteractionTypeApplicationCommand,
+			},
+			Id:             e.Discord.NewId(),
+			ApplicationId:  e.Worker.BotId,
+			GuildId:        objects.NewNullableSnowflake(e.Guild.Id),
+			ChannelId:      channelId,
+			Member:         &m,
+			Token:          "interaction-token",
+			AppPermissions: permission.BuildPermissions(permission.Administrator),
+			Locale:         "en-GB",
+			GuildLocale:    "en-GB",
+		},
+		Data: &interaction.ApplicationCommandInteractionData{
+			Id:   e.Discord.NewId(),
+			Name: name,
+			Type: interaction.ApplicationCommandTypeChatInput,
+		},
+	}
+
+	cmd := cmdcontext.NewSlashCommandContext(ctx, e.Worker, data, e.Premium.Tier, replies.ch)
+	return &cmd, replies
+}
+
+// Replies collects the interaction responses sent by a command context
+type Replies struct {
+	ch chan interaction.ApplicationCommandCallbackData
+
+	mu      sync.Mutex
+	replies []interaction.ApplicationCommandCallbackData
+}
+
+func newReplies() *Replies {
+	return &Replies{
+		// Commands may reply at most InteractionReplyLimit times, so sending never blocks
+		ch: make(chan interaction.ApplicationCommandCallbackData, cmdcontext.InteractionReplyLimit),
+	}
+}
+
+// Contains returns whether any reply so far included the message
+func (r *Replies) Contains(id i18n.MessageId) bool {
+	needle := fmt.Sprintf("`%s`", id)
+
+	for _, reply := range r.All() {
+		if strings.Contains(reply.Content, needle) {
+			return true
+		}
+
+		for _, e := range reply.Embeds {
+			if strings.Contains(e.Title, needle) || strings.Contains(e.Description, needle) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// All returns the replies sent so far
+func (r *Replies) All() []interaction.ApplicationCommandCallbackData {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for {
+		select {
+		case reply := <-r.ch:
+			r.replies = append(r.replies, reply)
+		default:
+			return append([]interaction.ApplicationCommandCallbackData(nil), r.replies...)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package testutil
+
+import (
+	"context"
+	"github.com/TicketsBot/common/model"
+	"github.com/TicketsBot/common/premium"
+	"github.com/rxdn/gdl/objects/guild"
+	"github.com/rxdn/gdl/rest/ratelimit"
+)
+
+// StaticPremium is a premium lookup client that reports the same tier for every guild and user
+type StaticPremium struct {
+	Tier premium.PremiumTier
+}
+
+var _ premium.IPremiumLookupClient = (*StaticPremium)(nil)
+
+func (p *StaticPremium) GetCachedTier(context.Context, uint64) (premium.CachedTier, error) {
+	return premium.CachedTier{Tier: int8(p.Tier), Source: model.EntitlementSourceDiscord}, nil
+}
+
+func (p *StaticPremium) SetCachedTier(context.Context, uint64, premium.CachedTier) error {
+	return nil
+}
+
+func (p *StaticPremium) DeleteCachedTier(context.Context, uint64) error {
+	return nil
+}
+
+func (p *StaticPremium) GetTierByGuild(context.Context, guild.Guild) (premium.PremiumTier, model.EntitlementSource, error) {
+	return p.Tier, model.EntitlementSourceDiscord, nil
+}
+
+func (p *StaticPremium) GetTierByGuildId(context.Context, uint64, bool, string, *ratelimit.Ratelimiter) (premium.PremiumTier, error) {
+	return p.Tier, nil
+}
+
+func (p *StaticPremium) GetTierByGuildIdWithSource(context.Context, uint64, string, *ratelimit.Ratelimiter) (premium.PremiumTier, model.EntitlementSource, error) {
+	return p.Tier, model.EntitlementSourceDiscord, nil
+}
+
+func (p *StaticPremium) GetTierByUser(context.Context, uint64, bool) (premium.PremiumTier, error) {
+	return p.Tier, nil
+}
+
+func (p *StaticPremium) GetTierByUserWithSource(context.Context, uint64) (premium.PremiumTier, model.EntitlementSource, error) {
+	return p.Tier, model.EntitlementSourceDiscord, nil
+}
@@ -0,0 +1,63 @@
+package testutil
+
+import (
+	"context"
+	"github.com/go-redis/redis/v8"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// RedisEnvKey is the env var holding the address of a Redis server that tests may use. Tests flush the selected
+// database, so this must not point at a server holding real data.
+const RedisEnvKey = "WORKER_TEST_REDIS_ADDR"
+
+// RedisDbEnvKey optionally selects the Redis database number, which defaults to 15
+const RedisDbEnvKey = "WORKER_TEST_REDIS_DB"
+
+// NewRedis returns a client for an empty Redis database, which is flushed again when the test finishes. The test is
+// skipped if no Redis server has been configured.
+func NewRedis(t testing.TB) *redis.Client {
+	t.Helper()
+
+	addr := os.Getenv(RedisEnvKey)
+	if addr == "" {
+		t.Skipf("%s is not set", RedisEnvKey)
+	}
+
+	db := 15
+	if value := os.Getenv(RedisDbEnvKey); value != "" {
+		var err error
+		if db, err = strconv.Atoi(value); err != nil {
+			t.Fatalf("%s must be a number: %v", RedisDbEnvKey, err)
+		}
+	}
+
+	client := redis.NewClient(&redis.Options{
+		Network: "tcp",
+		Addr:    addr,
+		DB:      db,
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancel()
+
+	if err := client.FlushDB(ctx).Err(); err != nil {
+		_ = client.Close()
+		t.Fatalf("failed to flush redis: %v", err)
+	}
+
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+		defer cancel()
+
+		if err := client.FlushDB(ctx).Err(); err != nil {
+			t.Logf("failed to flush redis: %v", err)
+		}
+
+		_ = client.Close()
+	})
+
+	return client
+}
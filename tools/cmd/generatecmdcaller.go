@@ -43,14 +43,12 @@ func main() {
 
 	allCmds := make([]registry.Command, 0, len(cm.GetCommands()))
 	for _, cmd := range cm.GetCommands() {
-		allCmds = append(allCmds, cmd)
-		for _, sub := range cmd.Properties().Children {
-			allCmds = append(allCmds, sub)
-		}
+		allCmds = appendWithChildren(allCmds, cmd)
 	}
 
 	var packagePaths []string
 	var executors []executorData
+	var usesAttachments bool
 
 	for _, cmd := range allCmds {
 		t := reflect.TypeOf(cmd)
@@ -61,6 +59,12 @@ func main() {
 
 		importName := pkg[strings.LastIndex(pkg, "/")+1:] + "." + t.Name()
 
+		for _, argument := range cmd.Properties().Arguments {
+			if argument.Type == interaction.OptionTypeAttachment {
+				usesAttachments = true
+			}
+		}
+
 		executors = append(executors, executorData{
 			ImportName: importName,
 			Arguments:  cmd.Properties().Arguments,
@@ -88,6 +92,8 @@ func main() {
 		"imports":   packagePaths,
 		"executors": executors,
 		"typeMap":   typeMap,
+		// The channel package is only imported when needed, as unused imports do not compile
+		"usesAttachments": usesAttachments,
 	}); err != nil {
 		panic(err)
 	}
@@ -97,3 +103,13 @@ func main() {
 		panic(err)
 	}
 }
+
+// appendWithChildren appends the command and its subcommands, including those in subcommand groups
+func appendWithChildren(cmds []registry.Command, cmd registry.Command) []registry.Command {
+	cmds = append(cmds, cmd)
+	for _, sub := range cmd.Properties().Children {
+		cmds = appendWithChildren(cmds, sub)
+	}
+
+	return cmds
+}